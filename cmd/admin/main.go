@@ -0,0 +1,135 @@
+package main
+
+import (
+	"avito-test-task/internal/config"
+	"avito-test-task/internal/database"
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/integration/github"
+	"avito-test-task/internal/integration/gitlab"
+	"avito-test-task/internal/repository/postgres"
+	"avito-test-task/internal/service"
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "rebuild-rollups":
+		rebuildRollups(os.Args[2:])
+	case "replay-webhook":
+		replayWebhook(os.Args[2:])
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: admin rebuild-rollups -from YYYY-MM-DD -to YYYY-MM-DD")
+	fmt.Fprintln(os.Stderr, "       admin replay-webhook [-provider github|gitlab] -file FIXTURE [-server URL]")
+	os.Exit(2)
+}
+
+// rebuildRollups recomputes the daily stats rollups for the UTC days from the
+// first date up to and including the second one.
+func rebuildRollups(args []string) {
+	fs := flag.NewFlagSet("rebuild-rollups", flag.ExitOnError)
+	fromFlag := fs.String("from", "", "first day to rebuild (YYYY-MM-DD, UTC)")
+	toFlag := fs.String("to", "", "last day to rebuild (YYYY-MM-DD, UTC), inclusive")
+	_ = fs.Parse(args)
+
+	from, err := time.Parse(dateLayout, *fromFlag)
+	if err != nil {
+		log.Fatalf("invalid -from: %v", err)
+	}
+	to, err := time.Parse(dateLayout, *toFlag)
+	if err != nil {
+		log.Fatalf("invalid -to: %v", err)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx := context.Background()
+	pool, err := database.NewPool(ctx, cfg.Database)
+	if err != nil {
+		log.Fatalf("failed to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	svc := service.NewService(
+		postgres.NewTeamRepo(pool),
+		postgres.NewUserRepo(pool),
+		postgres.NewPRRepo(pool),
+		postgres.NewAbsenceRepo(pool),
+		postgres.NewStatsRepo(pool),
+		postgres.NewIntegrationRepo(pool),
+		postgres.NewWebhookRepo(pool),
+		postgres.NewOutboxRepo(pool),
+		service.Config{TeamAliasTTL: cfg.Teams.AliasTTL},
+	)
+
+	if err := svc.RebuildStatsRollups(ctx, from, to.AddDate(0, 0, 1)); err != nil {
+		log.Fatalf("failed to rebuild rollups: %v", err)
+	}
+	log.Printf("Rebuilt stats rollups for %s..%s", *fromFlag, *toFlag)
+}
+
+// replayWebhook posts a recorded code host delivery to a running server. GitHub
+// deliveries are signed with GITHUB_WEBHOOK_SECRET and their event type is the
+// fixture name up to the first dot, as in testdata/pull_request.opened.json.
+// GitLab deliveries carry GITLAB_WEBHOOK_TOKEN and are merge request events.
+func replayWebhook(args []string) {
+	fs := flag.NewFlagSet("replay-webhook", flag.ExitOnError)
+	provider := fs.String("provider", string(domain.ProviderGitHub), "code host the fixture was recorded from (github, gitlab)")
+	file := fs.String("file", "", "recorded delivery payload")
+	server := fs.String("server", "http://localhost:8080", "server base URL")
+	_ = fs.Parse(args)
+
+	payload, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("failed to read fixture: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, *server+"/webhooks/"+*provider, bytes.NewReader(payload))
+	if err != nil {
+		log.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	switch domain.Provider(*provider) {
+	case domain.ProviderGitHub:
+		event, _, _ := strings.Cut(filepath.Base(*file), ".")
+		req.Header.Set(github.EventHeader, event)
+		req.Header.Set(github.SignatureHeader, github.Sign(os.Getenv("GITHUB_WEBHOOK_SECRET"), payload))
+	case domain.ProviderGitLab:
+		req.Header.Set(gitlab.EventHeader, gitlab.MergeRequestEvent)
+		req.Header.Set(gitlab.TokenHeader, os.Getenv("GITLAB_WEBHOOK_TOKEN"))
+	default:
+		log.Fatalf("unknown provider %q", *provider)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Fatalf("failed to deliver webhook: %v", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	fmt.Printf("%s\n%s", resp.Status, body)
+}
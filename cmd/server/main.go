@@ -1,6 +1,11 @@
 package main
 
-import "avito-test-task/internal/app"
+import (
+	"avito-test-task/internal/app"
+
+	// Working hours rely on IANA timezones; the runtime image has no tzdata.
+	_ "time/tzdata"
+)
 
 func main() {
 	app.App{}.MustRun()
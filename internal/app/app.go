@@ -4,8 +4,10 @@ import (
 	"avito-test-task/internal/config"
 	httpcontroller "avito-test-task/internal/controller/http"
 	"avito-test-task/internal/database"
+	"avito-test-task/internal/metrics"
 	"avito-test-task/internal/repository/postgres"
 	"avito-test-task/internal/service"
+	"avito-test-task/internal/webhook"
 	"context"
 	"errors"
 	"fmt"
@@ -38,16 +40,84 @@ func (app App) MustRun() {
 	teamRepo := postgres.NewTeamRepo(pool)
 	userRepo := postgres.NewUserRepo(pool)
 	prRepo := postgres.NewPRRepo(pool)
+	absenceRepo := postgres.NewAbsenceRepo(pool)
+	statsRepo := postgres.NewStatsRepo(pool)
+	integrationRepo := postgres.NewIntegrationRepo(pool)
+	webhookRepo := postgres.NewWebhookRepo(pool)
+	outboxRepo := postgres.NewOutboxRepo(pool)
+
+	// Metrics
+	m := metrics.New()
+	m.RegisterPool(pool)
 
 	// Service & Controller
-	svc := service.NewService(teamRepo, userRepo, prRepo)
-	ctrl := httpcontroller.NewController(svc)
+	svc := service.NewService(teamRepo, userRepo, prRepo, absenceRepo, statsRepo, integrationRepo, webhookRepo, outboxRepo, service.Config{
+		TeamAliasTTL:     cfg.Teams.AliasTTL,
+		Recorder:         m,
+		PseudonymKey:     []byte(cfg.Export.PseudonymKey),
+		WebhookSender:    webhook.NewSender(10 * time.Second),
+		ActorTokenSecret: cfg.Auth.ActorTokenSecret,
+	})
+	m.RegisterOpenReviews(svc.OpenReviewLoad)
+	ctrl := httpcontroller.NewController(svc, httpcontroller.Config{
+		GitHubWebhookSecret: cfg.Webhooks.GitHubSecret,
+		GitLabWebhookToken:  cfg.Webhooks.GitLabToken,
+		AdminToken:          cfg.Auth.AdminToken,
+		ActorTokenSecret:    cfg.Auth.ActorTokenSecret,
+	}, m.Middleware)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.Handler())
+	mux.Handle("/", ctrl.Handler)
+
+	// Background jobs
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+
+	go runPeriodic(jobsCtx, "absence reassignment", cfg.Jobs.AbsenceInterval, func(ctx context.Context) error {
+		reassigned, err := svc.ReassignStartedAbsences(ctx)
+		if len(reassigned) > 0 {
+			log.Printf("Reassigned %d reviews of absent users", len(reassigned))
+		}
+		return err
+	})
+
+	go runPeriodic(jobsCtx, "overdue review escalation", cfg.Jobs.OverdueInterval, func(ctx context.Context) error {
+		escalated, err := svc.EscalateOverdueReviews(ctx)
+		if len(escalated) > 0 {
+			log.Printf("Escalated %d overdue reviews to team leads", len(escalated))
+		}
+		return err
+	})
+
+	go runPeriodic(jobsCtx, "reviewer backfill", cfg.Jobs.BackfillInterval, func(ctx context.Context) error {
+		backfilled, err := svc.BackfillReviewers(ctx)
+		if len(backfilled) > 0 {
+			log.Printf("Backfilled reviewers on %d pull requests", len(backfilled))
+		}
+		return err
+	})
+
+	go runPeriodic(jobsCtx, "stats rollup", cfg.Jobs.RollupInterval, func(ctx context.Context) error {
+		_, err := svc.RefreshStatsRollups(ctx)
+		return err
+	})
+
+	go runPeriodic(jobsCtx, "outbox relay", cfg.Jobs.RelayInterval, func(ctx context.Context) error {
+		_, err := svc.RelayEvents(ctx)
+		return err
+	})
+
+	go runPeriodic(jobsCtx, "webhook delivery", cfg.Jobs.WebhookInterval, func(ctx context.Context) error {
+		_, err := svc.DeliverWebhooks(ctx)
+		return err
+	})
 
 	// Server
 	addr := fmt.Sprintf("0.0.0.0:%s", cfg.Server.Port)
 	server := &http.Server{
 		Addr:         addr,
-		Handler:      ctrl.Handler,
+		Handler:      mux,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 10 * time.Second,
 		IdleTimeout:  120 * time.Second,
@@ -69,6 +139,8 @@ func (app App) MustRun() {
 	<-quit
 	log.Println("Server is shutting down...")
 
+	stopJobs()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
@@ -0,0 +1,24 @@
+package app
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// runPeriodic calls fn every interval until ctx is cancelled.
+func runPeriodic(ctx context.Context, name string, interval time.Duration, fn func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := fn(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("%s failed: %v", name, err)
+			}
+		}
+	}
+}
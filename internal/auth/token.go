@@ -0,0 +1,69 @@
+// Package auth issues and checks the tokens that identify the acting user. A
+// token names the user and its expiry and is signed with a server secret, so a
+// client cannot act as someone else by editing it.
+package auth
+
+import (
+	"avito-test-task/internal/domain"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sign returns a token for the user that is valid until expiresAt. It has the
+// form user.expiry.signature, with the user id and signature base64url-encoded
+// and the expiry in Unix seconds.
+func Sign(secret, userID string, expiresAt time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(userID)) + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac(secret, payload))
+}
+
+// Verify returns the user a token was issued for. Malformed, forged and
+// expired tokens fail with domain.ErrUnauthorized, and so does every token
+// when no secret is configured.
+func Verify(secret, token string, now time.Time) (string, error) {
+	if secret == "" {
+		return "", fmt.Errorf("%w: actor tokens are not configured", domain.ErrUnauthorized)
+	}
+
+	payload, signature, ok := cutLast(token, ".")
+	if !ok {
+		return "", fmt.Errorf("%w: malformed actor token", domain.ErrUnauthorized)
+	}
+	got, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(got, mac(secret, payload)) {
+		return "", fmt.Errorf("%w: actor token signature does not match", domain.ErrUnauthorized)
+	}
+
+	// The signature is valid, so the payload is one Sign produced.
+	encodedUser, expiry, _ := strings.Cut(payload, ".")
+	user, err := base64.RawURLEncoding.DecodeString(encodedUser)
+	if err != nil {
+		return "", fmt.Errorf("%w: malformed actor token", domain.ErrUnauthorized)
+	}
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("%w: malformed actor token", domain.ErrUnauthorized)
+	}
+	if !now.Before(time.Unix(expiresAt, 0)) {
+		return "", fmt.Errorf("%w: actor token has expired", domain.ErrUnauthorized)
+	}
+	return string(user), nil
+}
+
+func mac(secret, payload string) []byte {
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write([]byte(payload))
+	return m.Sum(nil)
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
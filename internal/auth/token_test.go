@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"avito-test-task/internal/domain"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	const secret = "s3cret"
+	now := time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC)
+	valid := Sign(secret, "u1", now.Add(time.Hour))
+	payload, signature, _ := cutLast(valid, ".")
+	otherPayload, _, _ := cutLast(Sign(secret, "u2", now.Add(time.Hour)), ".")
+
+	tests := []struct {
+		name   string
+		secret string
+		token  string
+		want   string
+	}{
+		{name: "valid", secret: secret, token: valid, want: "u1"},
+		{name: "user id with dots", secret: secret, token: Sign(secret, "alice.dev", now.Add(time.Hour)), want: "alice.dev"},
+		{name: "expired", secret: secret, token: Sign(secret, "u1", now)},
+		{name: "other secret", secret: secret, token: Sign("other", "u1", now.Add(time.Hour))},
+		{name: "other user", secret: secret, token: otherPayload + "." + signature},
+		{name: "extended expiry", secret: secret, token: payload + "0." + signature},
+		{name: "bare user id", secret: secret, token: "u1"},
+		{name: "malformed signature", secret: secret, token: payload + ".!!"},
+		{name: "empty", secret: secret, token: ""},
+		{name: "no secret configured", secret: "", token: Sign("", "u1", now.Add(time.Hour))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(tt.secret, tt.token, now)
+			if tt.want == "" {
+				if !errors.Is(err, domain.ErrUnauthorized) {
+					t.Errorf("Verify = %q, %v; want %v", got, err, domain.ErrUnauthorized)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Verify = %q, %v; want %q", got, err, tt.want)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package config
 import (
 	"avito-test-task/internal/database"
 	"errors"
+	"fmt"
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -12,10 +14,46 @@ type Config struct {
 	}
 
 	Database database.Config
+
+	Teams struct {
+		AliasTTL time.Duration
+	}
+	Export struct {
+		PseudonymKey string
+	}
+	Auth struct {
+		AdminToken       string
+		ActorTokenSecret string
+	}
+	Webhooks struct {
+		GitHubSecret string
+		GitLabToken  string
+	}
+
+	Jobs struct {
+		AbsenceInterval  time.Duration
+		OverdueInterval  time.Duration
+		BackfillInterval time.Duration
+		RollupInterval   time.Duration
+		WebhookInterval  time.Duration
+		RelayInterval    time.Duration
+	}
 }
 
 const databaseDSNEnvKey = "DATABASE_URL"
 const serverPortEnvKey = "PORT"
+const absenceIntervalEnvKey = "ABSENCE_CHECK_INTERVAL"
+const overdueIntervalEnvKey = "OVERDUE_CHECK_INTERVAL"
+const backfillIntervalEnvKey = "BACKFILL_INTERVAL"
+const rollupIntervalEnvKey = "STATS_ROLLUP_INTERVAL"
+const webhookIntervalEnvKey = "WEBHOOK_DELIVERY_INTERVAL"
+const relayIntervalEnvKey = "OUTBOX_RELAY_INTERVAL"
+const teamAliasTTLEnvKey = "TEAM_ALIAS_TTL"
+const exportPseudonymKeyEnvKey = "EXPORT_PSEUDONYM_KEY"
+const adminTokenEnvKey = "ADMIN_TOKEN"
+const actorTokenSecretEnvKey = "ACTOR_TOKEN_SECRET"
+const githubWebhookSecretEnvKey = "GITHUB_WEBHOOK_SECRET"
+const gitlabWebhookTokenEnvKey = "GITLAB_WEBHOOK_TOKEN"
 
 func Load() (Config, error) {
 	var cfg Config
@@ -27,6 +65,41 @@ func Load() (Config, error) {
 
 	cfg.Server.Port = getEnv(serverPortEnvKey, "8080")
 
+	var err error
+	cfg.Jobs.AbsenceInterval, err = getDurationEnv(absenceIntervalEnvKey, time.Minute)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Jobs.OverdueInterval, err = getDurationEnv(overdueIntervalEnvKey, 5*time.Minute)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Jobs.BackfillInterval, err = getDurationEnv(backfillIntervalEnvKey, 5*time.Minute)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Jobs.RollupInterval, err = getDurationEnv(rollupIntervalEnvKey, time.Minute)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Jobs.WebhookInterval, err = getDurationEnv(webhookIntervalEnvKey, 5*time.Second)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Jobs.RelayInterval, err = getDurationEnv(relayIntervalEnvKey, time.Second)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Teams.AliasTTL, err = getDurationEnv(teamAliasTTLEnvKey, 30*24*time.Hour)
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Export.PseudonymKey = os.Getenv(exportPseudonymKeyEnvKey)
+	cfg.Auth.AdminToken = os.Getenv(adminTokenEnvKey)
+	cfg.Auth.ActorTokenSecret = os.Getenv(actorTokenSecretEnvKey)
+	cfg.Webhooks.GitHubSecret = os.Getenv(githubWebhookSecretEnvKey)
+	cfg.Webhooks.GitLabToken = os.Getenv(gitlabWebhookTokenEnvKey)
+
 	return cfg, nil
 }
 
@@ -37,3 +110,16 @@ func getEnv(key, fallback string) string {
 
 	return fallback
 }
+
+func getDurationEnv(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%s env variable must be a positive duration, got %q", key, value)
+	}
+	return d, nil
+}
@@ -0,0 +1,133 @@
+package http
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/pkg/api"
+	"encoding/json"
+	"net/http"
+)
+
+func (c *Controller) PostUsersAbsenceAdd(w http.ResponseWriter, r *http.Request, params api.PostUsersAbsenceAddParams) {
+	var body api.PostUsersAbsenceAddJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	absence := domain.Absence{
+		UserID:          body.UserId,
+		StartsAt:        body.StartsAt,
+		EndsAt:          body.EndsAt,
+		ReassignOnStart: body.ReassignOnStart != nil && *body.ReassignOnStart,
+	}
+	if body.Reason != nil {
+		absence.Reason = *body.Reason
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	created, reassigned, err := c.service.CreateAbsence(r.Context(), actor, absence)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		Absence    api.Absence              `json:"absence"`
+		Reassigned []api.ReviewReassignment `json:"reassigned"`
+	}{
+		Absence:    c.mapDomainAbsenceToAPI(created),
+		Reassigned: c.mapReassignmentsToAPI(reassigned),
+	}
+	c.respondJSON(w, http.StatusCreated, response)
+}
+
+func (c *Controller) GetUsersAbsenceList(w http.ResponseWriter, r *http.Request, params api.GetUsersAbsenceListParams) {
+	userID := string(params.UserId)
+	includePast := params.IncludePast != nil && *params.IncludePast
+
+	absences, err := c.service.ListAbsences(r.Context(), userID, includePast)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	apiAbsences := make([]api.Absence, len(absences))
+	for i, a := range absences {
+		apiAbsences[i] = c.mapDomainAbsenceToAPI(a)
+	}
+
+	response := struct {
+		UserId   string        `json:"user_id"`
+		Absences []api.Absence `json:"absences"`
+	}{
+		UserId:   userID,
+		Absences: apiAbsences,
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostUsersAbsenceUpdate(w http.ResponseWriter, r *http.Request, params api.PostUsersAbsenceUpdateParams) {
+	var body api.PostUsersAbsenceUpdateJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	updated, reassigned, err := c.service.UpdateAbsence(r.Context(), actor, body.AbsenceId, domain.AbsenceUpdate{
+		StartsAt:        body.StartsAt,
+		EndsAt:          body.EndsAt,
+		Reason:          body.Reason,
+		ReassignOnStart: body.ReassignOnStart,
+	})
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		Absence    api.Absence              `json:"absence"`
+		Reassigned []api.ReviewReassignment `json:"reassigned"`
+	}{
+		Absence:    c.mapDomainAbsenceToAPI(updated),
+		Reassigned: c.mapReassignmentsToAPI(reassigned),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) DeleteUsersAbsence(w http.ResponseWriter, r *http.Request, params api.DeleteUsersAbsenceParams) {
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	if err := c.service.DeleteAbsence(r.Context(), actor, int64(params.AbsenceId)); err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *Controller) mapDomainAbsenceToAPI(a domain.Absence) api.Absence {
+	return api.Absence{
+		AbsenceId:       a.ID,
+		UserId:          a.UserID,
+		StartsAt:        a.StartsAt,
+		EndsAt:          a.EndsAt,
+		Reason:          a.Reason,
+		ReassignOnStart: a.ReassignOnStart,
+		ReassignedAt:    a.ReassignedAt,
+	}
+}
@@ -6,23 +6,40 @@ import (
 	"avito-test-task/pkg/api"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 )
 
+// Config holds the secrets requests are verified with. A webhook whose secret
+// is empty rejects every delivery; without AdminToken nobody acts as admin.
+type Config struct {
+	GitHubWebhookSecret string
+	GitLabWebhookToken  string
+	AdminToken          string
+	// ActorTokenSecret verifies X-Actor-Token; without it only the admin token
+	// identifies callers.
+	ActorTokenSecret string
+}
+
 type Controller struct {
 	service service.Service
+	cfg     Config
 	Handler http.Handler
 }
 
-func NewController(s service.Service) *Controller {
+// NewController builds the API router. The extra middlewares run after the
+// request logger and outside the panic recoverer.
+func NewController(s service.Service, cfg Config, middlewares ...func(http.Handler) http.Handler) *Controller {
 	c := &Controller{
 		service: s,
+		cfg:     cfg,
 	}
 
 	r := chi.NewRouter()
 	r.Use(middleware.Logger)
+	r.Use(middlewares...)
 	r.Use(middleware.Recoverer)
 
 	api.HandlerFromMux(c, r)
@@ -31,34 +48,75 @@ func NewController(s service.Service) *Controller {
 	return c
 }
 
-func (c *Controller) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var body api.Team
+func (c *Controller) PostTeamAdd(w http.ResponseWriter, r *http.Request, params api.PostTeamAddParams) {
+	var body api.PostTeamAddJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		http.Error(w, "invalid request body", http.StatusBadRequest)
 		return
 	}
 
+	policy := domain.ConflictPolicyFail
+	if body.ConflictPolicy != nil {
+		policy = domain.ConflictPolicy(*body.ConflictPolicy)
+	}
+	if !policy.Valid() {
+		http.Error(w, "invalid conflict_policy", http.StatusBadRequest)
+		return
+	}
+
 	members := make([]domain.User, len(body.Members))
 	for i, m := range body.Members {
 		members[i] = domain.User{
 			ID:       m.UserId,
 			Username: m.Username,
+			Role:     domain.RoleMember,
 			IsActive: m.IsActive,
 		}
+		if m.Role != nil {
+			members[i].Role = domain.TeamRole(*m.Role)
+		}
+		if !members[i].Role.Valid() {
+			http.Error(w, "invalid role", http.StatusBadRequest)
+			return
+		}
+		body.Members[i].Role = c.mapDomainRoleToAPI(members[i].Role)
 	}
 	team := domain.Team{
-		Name:    body.TeamName,
-		Members: members,
+		Name:       body.TeamName,
+		ParentName: body.ParentTeamName,
+		Members:    members,
 	}
 
-	if err := c.service.CreateTeam(r.Context(), team); err != nil {
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	skipped, err := c.service.CreateTeam(r.Context(), actor, team, policy)
+	if err != nil {
 		c.respondError(w, err)
 		return
 	}
 
+	skippedIDs := make(map[string]bool, len(skipped))
+	for _, s := range skipped {
+		skippedIDs[s.UserID] = true
+	}
+	added := make([]api.TeamMember, 0, len(body.Members))
+	for _, m := range body.Members {
+		if !skippedIDs[m.UserId] {
+			added = append(added, m)
+		}
+	}
+
 	response := struct {
-		Team api.Team `json:"team"`
-	}{Team: body}
+		Team    api.Team             `json:"team"`
+		Skipped []api.MemberConflict `json:"skipped,omitempty"`
+	}{
+		Team:    api.Team{TeamName: body.TeamName, ParentTeamName: body.ParentTeamName, Members: added},
+		Skipped: c.mapMemberConflictsToAPI(skipped),
+	}
 
 	c.respondJSON(w, http.StatusCreated, response)
 }
@@ -77,38 +135,642 @@ func (c *Controller) GetTeamGet(w http.ResponseWriter, r *http.Request, params a
 		members[i] = api.TeamMember{
 			UserId:   m.ID,
 			Username: m.Username,
+			Role:     c.mapDomainRoleToAPI(m.Role),
 			IsActive: m.IsActive,
 		}
 	}
 
-	c.respondJSON(w, http.StatusOK, api.Team{
-		TeamName: team.Name,
-		Members:  members,
+	c.respondJSON(w, http.StatusOK, c.mapDomainTeamToAPI(team, members))
+}
+
+func (c *Controller) GetTeamTree(w http.ResponseWriter, r *http.Request, params api.GetTeamTreeParams) {
+	includeArchived := params.IncludeArchived != nil && *params.IncludeArchived
+
+	tree, err := c.service.GetTeamTree(r.Context(), params.TeamName, includeArchived)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		Teams []api.TeamNode `json:"teams"`
+	}{
+		Teams: c.mapDomainTeamNodesToAPI(tree),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostTeamSetParent(w http.ResponseWriter, r *http.Request, params api.PostTeamSetParentParams) {
+	var body api.PostTeamSetParentJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	team, err := c.service.SetTeamParent(r.Context(), actor, body.TeamName, body.ParentTeamName)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	c.respondJSON(w, http.StatusOK, c.mapDomainTeamToAPI(team, []api.TeamMember{}))
+}
+
+func (c *Controller) GetTeamList(w http.ResponseWriter, r *http.Request, params api.GetTeamListParams) {
+	page, err := c.mapPageRequest(params.Limit, params.Cursor, (*string)(params.SortBy), (*string)(params.Order))
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	filter := domain.TeamFilter{
+		IncludeArchived: params.IncludeArchived != nil && *params.IncludeArchived,
+	}
+
+	teams, next, err := c.service.ListTeams(r.Context(), filter, page)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	summaries := make([]api.TeamSummary, len(teams))
+	for i, t := range teams {
+		summaries[i] = api.TeamSummary{
+			TeamName:    t.Name,
+			MemberCount: t.MemberCount,
+			ActiveCount: t.ActiveCount,
+			OpenPrCount: t.OpenPRCount,
+			ArchivedAt:  t.ArchivedAt,
+		}
+	}
+
+	response := struct {
+		Teams      []api.TeamSummary `json:"teams"`
+		NextCursor *string           `json:"next_cursor"`
+	}{
+		Teams:      summaries,
+		NextCursor: c.nextCursor(next),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostTeamArchive(w http.ResponseWriter, r *http.Request, params api.PostTeamArchiveParams) {
+	var body api.PostTeamArchiveJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	archivedAt, deactivated, reassigned, err := c.service.ArchiveTeam(r.Context(), actor, body.TeamName)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	if deactivated == nil {
+		deactivated = []string{}
+	}
+
+	response := struct {
+		TeamName    string                   `json:"team_name"`
+		ArchivedAt  time.Time                `json:"archived_at"`
+		Deactivated []string                 `json:"deactivated"`
+		Reassigned  []api.ReviewReassignment `json:"reassigned"`
+	}{
+		TeamName:    body.TeamName,
+		ArchivedAt:  archivedAt,
+		Deactivated: deactivated,
+		Reassigned:  c.mapReassignmentsToAPI(reassigned),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) DeleteTeam(w http.ResponseWriter, r *http.Request, params api.DeleteTeamParams) {
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	if err := c.service.DeleteTeam(r.Context(), actor, string(params.TeamName)); err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *Controller) PostTeamRename(w http.ResponseWriter, r *http.Request, params api.PostTeamRenameParams) {
+	var body api.PostTeamRenameJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	renamed, err := c.service.RenameTeam(r.Context(), actor, body.TeamName, body.NewTeamName)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		TeamName         string    `json:"team_name"`
+		PreviousTeamName string    `json:"previous_team_name"`
+		AliasExpiresAt   time.Time `json:"alias_expires_at"`
+	}{
+		TeamName:         renamed.NewName,
+		PreviousTeamName: renamed.OldName,
+		AliasExpiresAt:   renamed.AliasExpiresAt,
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostTeamUpdateSettings(w http.ResponseWriter, r *http.Request, params api.PostTeamUpdateSettingsParams) {
+	var body api.PostTeamUpdateSettingsJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	update := domain.TeamSettingsUpdate{
+		Set: domain.TeamSettingsOverrides{
+			ReassignOnDeactivate: body.ReassignOnDeactivate,
+			ReviewerSelection:    (*domain.ReviewerSelection)(body.ReviewerSelection),
+			ReviewSLAHours:       body.ReviewSlaHours,
+			ReviewerCount:        body.ReviewerCount,
+			EscalateToParent:     body.EscalateToParent,
+			NoCandidatePolicy:    (*domain.NoCandidatePolicy)(body.NoCandidatePolicy),
+			MergePolicy:          (*domain.MergePolicy)(body.MergePolicy),
+		},
+	}
+	if body.Inherit != nil {
+		for _, setting := range *body.Inherit {
+			update.Inherit = append(update.Inherit, string(setting))
+		}
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	team, err := c.service.UpdateTeamSettings(r.Context(), actor, body.TeamName, update)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		TeamName  string                    `json:"team_name"`
+		Settings  api.TeamSettings          `json:"settings"`
+		Overrides api.TeamSettingsOverrides `json:"overrides"`
+	}{
+		TeamName:  body.TeamName,
+		Settings:  c.mapDomainTeamSettingsToAPI(team.Settings),
+		Overrides: c.mapDomainTeamOverridesToAPI(team.Overrides),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostTeamSetMemberRole(w http.ResponseWriter, r *http.Request, params api.PostTeamSetMemberRoleParams) {
+	var body api.PostTeamSetMemberRoleJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	user, err := c.service.SetTeamMemberRole(r.Context(), actor, body.TeamName, body.UserId, domain.TeamRole(body.Role))
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		User api.User `json:"user"`
+	}{
+		User: c.mapDomainUserToAPI(user),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostTeamAddMember(w http.ResponseWriter, r *http.Request, params api.PostTeamAddMemberParams) {
+	var body api.PostTeamAddMemberJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	var role domain.TeamRole
+	if body.Role != nil {
+		role = domain.TeamRole(*body.Role)
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	user, err := c.service.AddTeamMember(r.Context(), actor, body.TeamName, body.UserId, role)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		User api.User `json:"user"`
+	}{
+		User: c.mapDomainUserToAPI(user),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request, params api.PostTeamRemoveMemberParams) {
+	var body api.PostTeamRemoveMemberJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	reassigned, err := c.service.RemoveTeamMember(r.Context(), actor, body.TeamName, body.UserId)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		Reassigned []api.ReviewReassignment `json:"reassigned"`
+	}{
+		Reassigned: c.mapReassignmentsToAPI(reassigned),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostTeamBackfillReviewers(w http.ResponseWriter, r *http.Request, params api.PostTeamBackfillReviewersParams) {
+	var body api.PostTeamBackfillReviewersJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+	if body.TeamName == "" {
+		http.Error(w, "team_name is required", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	backfilled, err := c.service.BackfillTeamReviewers(r.Context(), actor, body.TeamName)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		TeamName   string               `json:"team_name"`
+		Backfilled []api.ReviewBackfill `json:"backfilled"`
+	}{
+		TeamName:   body.TeamName,
+		Backfilled: c.mapBackfillsToAPI(backfilled),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostTeamDeactivateMembers(w http.ResponseWriter, r *http.Request, params api.PostTeamDeactivateMembersParams) {
+	var body api.PostTeamDeactivateMembersJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	allExcept := body.AllExcept != nil && *body.AllExcept
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	deactivated, reassigned, err := c.service.DeactivateTeamMembers(r.Context(), actor, body.TeamName, body.UserIds, allExcept)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	if deactivated == nil {
+		deactivated = []string{}
+	}
+
+	response := struct {
+		TeamName    string                   `json:"team_name"`
+		Deactivated []string                 `json:"deactivated"`
+		Reassigned  []api.ReviewReassignment `json:"reassigned"`
+	}{
+		TeamName:    body.TeamName,
+		Deactivated: deactivated,
+		Reassigned:  c.mapReassignmentsToAPI(reassigned),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) GetUsersGet(w http.ResponseWriter, r *http.Request, params api.GetUsersGetParams) {
+	profile, err := c.service.GetUser(r.Context(), string(params.UserId))
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		User api.UserProfile `json:"user"`
+	}{
+		User: c.mapDomainProfileToAPI(profile),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostUsersUpdate(w http.ResponseWriter, r *http.Request, params api.PostUsersUpdateParams) {
+	var body api.PostUsersUpdateJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	workStart, err := c.parseClock(body.WorkStart)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+	workEnd, err := c.parseClock(body.WorkEnd)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	profile, err := c.service.UpdateUser(r.Context(), actor, body.UserId, domain.UserUpdate{
+		Username:  body.Username,
+		Timezone:  body.Timezone,
+		WorkStart: workStart,
+		WorkEnd:   workEnd,
 	})
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		User api.UserProfile `json:"user"`
+	}{
+		User: c.mapDomainProfileToAPI(profile),
+	}
+	c.respondJSON(w, http.StatusOK, response)
 }
 
-func (c *Controller) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+func (c *Controller) GetUsersList(w http.ResponseWriter, r *http.Request, params api.GetUsersListParams) {
+	page, err := c.mapPageRequest(params.Limit, params.Cursor, (*string)(params.SortBy), (*string)(params.Order))
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	filter := domain.UserFilter{
+		TeamName: params.TeamName,
+		IsActive: params.IsActive,
+	}
+	if params.Search != nil {
+		filter.Search = *params.Search
+	}
+
+	users, next, err := c.service.ListUsers(r.Context(), filter, page)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	apiUsers := make([]api.User, len(users))
+	for i, u := range users {
+		apiUsers[i] = c.mapDomainUserToAPI(u)
+	}
+
+	response := struct {
+		Users      []api.User `json:"users"`
+		NextCursor *string    `json:"next_cursor"`
+	}{
+		Users:      apiUsers,
+		NextCursor: c.nextCursor(next),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params api.PostUsersSetIsActiveParams) {
 	var body api.PostUsersSetIsActiveJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		http.Error(w, "invalid body", http.StatusBadRequest)
 		return
 	}
 
-	user, err := c.service.SetUserActive(r.Context(), body.UserId, body.IsActive)
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	user, reassigned, err := c.service.SetUserActive(r.Context(), actor, body.UserId, body.IsActive, body.ReassignReviews)
 	if err != nil {
 		c.respondError(w, err)
 		return
 	}
 
 	response := struct {
-		User api.User `json:"user"`
+		User       api.User                 `json:"user"`
+		Reassigned []api.ReviewReassignment `json:"reassigned"`
 	}{
-		User: api.User{
-			UserId:   user.ID,
-			Username: user.Username,
-			TeamName: user.TeamName,
-			IsActive: user.IsActive,
-		},
+		User:       c.mapDomainUserToAPI(user),
+		Reassigned: c.mapReassignmentsToAPI(reassigned),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostUsersTransfer(w http.ResponseWriter, r *http.Request, params api.PostUsersTransferParams) {
+	var body api.PostUsersTransferJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	policy := domain.ReviewPolicyFail
+	if body.ReviewPolicy != nil {
+		policy = domain.ReviewPolicy(*body.ReviewPolicy)
+	}
+	switch policy {
+	case domain.ReviewPolicyKeep, domain.ReviewPolicyReassign, domain.ReviewPolicyFail:
+	default:
+		http.Error(w, "invalid review_policy", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	user, reassigned, err := c.service.TransferUser(r.Context(), actor, body.UserId, body.TeamName, policy)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		User       api.User                 `json:"user"`
+		Reassigned []api.ReviewReassignment `json:"reassigned"`
+	}{
+		User:       c.mapDomainUserToAPI(user),
+		Reassigned: c.mapReassignmentsToAPI(reassigned),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostUsersIssueToken(w http.ResponseWriter, r *http.Request, params api.PostUsersIssueTokenParams) {
+	var body api.PostUsersIssueTokenJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	var ttl time.Duration
+	if body.TtlSeconds != nil {
+		// Larger values are refused by the service; checking here keeps the
+		// conversion from overflowing.
+		if *body.TtlSeconds <= 0 || *body.TtlSeconds > int(service.MaxActorTokenTTL/time.Second) {
+			http.Error(w, "invalid ttl_seconds", http.StatusBadRequest)
+			return
+		}
+		ttl = time.Duration(*body.TtlSeconds) * time.Second
+	}
+
+	actor, err := c.actor(nil, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	token, expiresAt, err := c.service.IssueActorToken(r.Context(), actor, body.UserId, ttl)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostPullRequestDecide(w http.ResponseWriter, r *http.Request, params api.PostPullRequestDecideParams) {
+	var body api.PostPullRequestDecideJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	pr, err := c.service.DecideReview(r.Context(), actor, body.PullRequestId, domain.ReviewDecision(body.Decision))
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		Pr api.PullRequest `json:"pr"`
+	}{
+		Pr: c.mapDomainPRToAPI(pr),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) GetPullRequestSla(w http.ResponseWriter, r *http.Request, params api.GetPullRequestSlaParams) {
+	sla, err := c.service.GetReviewSLA(r.Context(), params.PullRequestId)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	type reviewerSLA struct {
+		UserId                 string     `json:"user_id"`
+		AssignedAt             time.Time  `json:"assigned_at"`
+		BusinessMinutesElapsed int        `json:"business_minutes_elapsed"`
+		DueAt                  *time.Time `json:"due_at"`
+		Overdue                bool       `json:"overdue"`
+	}
+
+	reviewers := make([]reviewerSLA, len(sla.Reviewers))
+	for i, rs := range sla.Reviewers {
+		reviewers[i] = reviewerSLA{
+			UserId:                 rs.ReviewerID,
+			AssignedAt:             rs.AssignedAt,
+			BusinessMinutesElapsed: int(rs.BusinessElapsed / time.Minute),
+			DueAt:                  rs.DueAt,
+			Overdue:                rs.Overdue,
+		}
+	}
+
+	response := struct {
+		PullRequestId  string        `json:"pull_request_id"`
+		ReviewSlaHours *int          `json:"review_sla_hours"`
+		Reviewers      []reviewerSLA `json:"reviewers"`
+	}{
+		PullRequestId:  sla.PullRequestID,
+		ReviewSlaHours: sla.ReviewSLAHours,
+		Reviewers:      reviewers,
 	}
 	c.respondJSON(w, http.StatusOK, response)
 }
@@ -154,6 +816,9 @@ func (c *Controller) PostPullRequestCreate(w http.ResponseWriter, r *http.Reques
 		Name:     body.PullRequestName,
 		AuthorID: body.AuthorId,
 	}
+	if body.TeamName != nil {
+		req.TeamName = *body.TeamName
+	}
 
 	pr, err := c.service.CreatePR(r.Context(), req)
 	if err != nil {
@@ -190,14 +855,25 @@ func (c *Controller) PostPullRequestMerge(w http.ResponseWriter, r *http.Request
 	c.respondJSON(w, http.StatusOK, response)
 }
 
-func (c *Controller) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+func (c *Controller) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params api.PostPullRequestReassignParams) {
 	var body api.PostPullRequestReassignJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		http.Error(w, "invalid body", http.StatusBadRequest)
 		return
 	}
 
-	pr, newReviewerID, err := c.service.ReassignReviewer(r.Context(), body.PullRequestId, body.OldUserId)
+	policy := domain.NoCandidateFail
+	if body.OnNoCandidate != nil {
+		policy = domain.NoCandidatePolicy(*body.OnNoCandidate)
+	}
+
+	actor, err := c.actor(params.XActorToken, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	pr, newReviewerID, outcome, err := c.service.ReassignReviewer(r.Context(), actor, body.PullRequestId, body.OldUserId, policy)
 	if err != nil {
 		c.respondError(w, err)
 		return
@@ -205,10 +881,12 @@ func (c *Controller) PostPullRequestReassign(w http.ResponseWriter, r *http.Requ
 
 	response := struct {
 		Pr         api.PullRequest `json:"pr"`
-		ReplacedBy string          `json:"replaced_by"`
+		ReplacedBy *string         `json:"replaced_by"`
+		Outcome    string          `json:"outcome"`
 	}{
 		Pr:         c.mapDomainPRToAPI(pr),
-		ReplacedBy: newReviewerID,
+		ReplacedBy: c.optionalString(newReviewerID),
+		Outcome:    string(outcome),
 	}
 	c.respondJSON(w, http.StatusOK, response)
 }
@@ -0,0 +1,183 @@
+package http
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/pkg/api"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// parquetRowGroupSize is the number of rows buffered before a row group is
+// written out.
+const parquetRowGroupSize = 1000
+
+func (c *Controller) GetExportDataset(w http.ResponseWriter, r *http.Request, dataset api.GetExportDatasetParamsDataset, params api.GetExportDatasetParams) {
+	format := api.Csv
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != api.Csv && format != api.Parquet {
+		c.respondError(w, fmt.Errorf("%w: unknown format %q", domain.ErrInvalidInput, format))
+		return
+	}
+
+	req := domain.ExportRequest{
+		Dataset: domain.ExportDataset(dataset),
+		Window:  domain.StatsWindow{From: params.From, To: params.To},
+	}
+	if params.Pseudonymize != nil {
+		req.Pseudonymize = *params.Pseudonymize
+	}
+	if params.Limit != nil {
+		req.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		req.Cursor = *params.Cursor
+	}
+
+	page, err := c.service.ExportData(r.Context(), req)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	ew := &exportWriter{ResponseWriter: w, setHeaders: func(h http.Header) {
+		if page.NextCursor != "" {
+			h.Set("X-Next-Cursor", page.NextCursor)
+		}
+		h.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fmt.Sprintf("%s.%s", dataset, format)))
+		if format == api.Parquet {
+			h.Set("Content-Type", "application/vnd.apache.parquet")
+		} else {
+			h.Set("Content-Type", "text/csv")
+		}
+	}}
+	if format == api.Parquet {
+		err = writeParquet(ew, page)
+	} else {
+		err = writeCSV(ew, page)
+	}
+	if err == nil {
+		return
+	}
+	if !ew.started {
+		c.respondError(w, err)
+		return
+	}
+	// The status line is gone already; cut the response short so that the
+	// client does not take a truncated page for a complete file.
+	panic(http.ErrAbortHandler)
+}
+
+// exportWriter sets the download headers with the first write. The writers
+// buffer their output, so an export that fails early is still answered with
+// an error response instead of a file.
+type exportWriter struct {
+	http.ResponseWriter
+	setHeaders func(http.Header)
+	started    bool
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.setHeaders(w.Header())
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// writeCSV streams the page with a header row. Times are RFC 3339 in UTC and
+// NULLs are empty fields.
+func writeCSV(w io.Writer, page domain.ExportPage) error {
+	cw := csv.NewWriter(w)
+	record := make([]string, len(page.Columns))
+	for i, col := range page.Columns {
+		record[i] = col.Name
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+
+	for row, err := range page.Rows {
+		if err != nil {
+			return err
+		}
+		for i, v := range row {
+			switch v := v.(type) {
+			case nil:
+				record[i] = ""
+			case string:
+				record[i] = v
+			case time.Time:
+				record[i] = v.UTC().Format(time.RFC3339Nano)
+			case int64:
+				record[i] = strconv.FormatInt(v, 10)
+			default:
+				record[i] = fmt.Sprint(v)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeParquet streams the page as a parquet file with a nullable column per
+// export column. Times are stored as UTC timestamps in microseconds. Row groups
+// are kept small, so that rows are flushed as they come instead of buffered.
+func writeParquet(w io.Writer, page domain.ExportPage) error {
+	group := make(parquet.Group, len(page.Columns))
+	for _, col := range page.Columns {
+		switch col.Type {
+		case domain.ExportTime:
+			group[col.Name] = parquet.Optional(parquet.Timestamp(parquet.Microsecond))
+		case domain.ExportInt:
+			group[col.Name] = parquet.Optional(parquet.Int(64))
+		default:
+			group[col.Name] = parquet.Optional(parquet.String())
+		}
+	}
+	schema := parquet.NewSchema(string(page.Dataset), group)
+
+	// The schema orders columns by name, so map each export column to its leaf.
+	leaves := make([]int, len(page.Columns))
+	for i, col := range page.Columns {
+		leaf, _ := schema.Lookup(col.Name)
+		leaves[i] = leaf.ColumnIndex
+	}
+
+	pw := parquet.NewWriter(w, schema, parquet.MaxRowsPerRowGroup(parquetRowGroupSize))
+	values := make(parquet.Row, len(page.Columns))
+	for row, err := range page.Rows {
+		if err != nil {
+			return err
+		}
+		for i, v := range row {
+			var value parquet.Value
+			switch v := v.(type) {
+			case string:
+				value = parquet.ByteArrayValue([]byte(v)).Level(0, 1, leaves[i])
+			case time.Time:
+				value = parquet.Int64Value(v.UnixMicro()).Level(0, 1, leaves[i])
+			case int64:
+				value = parquet.Int64Value(v).Level(0, 1, leaves[i])
+			default:
+				value = parquet.NullValue().Level(0, 0, leaves[i])
+			}
+			values[leaves[i]] = value
+		}
+		if _, err := pw.WriteRows([]parquet.Row{values}); err != nil {
+			return err
+		}
+	}
+	return pw.Close()
+}
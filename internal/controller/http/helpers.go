@@ -1,12 +1,16 @@
 package http
 
 import (
+	"avito-test-task/internal/auth"
 	"avito-test-task/internal/domain"
 	"avito-test-task/pkg/api"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"net/http"
+	"time"
 )
 
 func (c *Controller) respondJSON(w http.ResponseWriter, status int, payload interface{}) {
@@ -25,12 +29,207 @@ func (c *Controller) mapDomainPRToAPI(pr domain.PullRequest) api.PullRequest {
 		PullRequestName:   pr.Name,
 		AuthorId:          pr.AuthorID,
 		Status:            api.PullRequestStatus(pr.Status),
+		TeamName:          c.optionalString(pr.TeamName),
 		AssignedReviewers: pr.Reviewers,
 		CreatedAt:         &pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
+		ClosedAt:          pr.ClosedAt,
+		FirstDecisionAt:   pr.FirstDecisionAt,
 	}
 }
 
+func (c *Controller) mapDomainUserToAPI(u domain.User) api.User {
+	return api.User{
+		UserId:   u.ID,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		Role:     c.mapDomainRoleToAPI(u.Role),
+		IsActive: u.IsActive,
+	}
+}
+
+func (c *Controller) mapDomainRoleToAPI(role domain.TeamRole) *api.TeamRole {
+	if role == "" {
+		return nil
+	}
+	r := api.TeamRole(role)
+	return &r
+}
+
+// actor identifies the caller from the X-Actor-Token and X-Admin-Token headers.
+// A wrong token of either kind is rejected rather than ignored.
+func (c *Controller) actor(actorToken *api.ActorTokenHeader, adminToken *api.AdminTokenHeader) (domain.Actor, error) {
+	var actor domain.Actor
+	if actorToken != nil {
+		userID, err := auth.Verify(c.cfg.ActorTokenSecret, string(*actorToken), time.Now())
+		if err != nil {
+			return domain.Actor{}, err
+		}
+		actor.UserID = userID
+	}
+	if adminToken != nil {
+		token := []byte(*adminToken)
+		if c.cfg.AdminToken == "" || subtle.ConstantTimeCompare(token, []byte(c.cfg.AdminToken)) != 1 {
+			return domain.Actor{}, fmt.Errorf("%w: admin token does not match", domain.ErrUnauthorized)
+		}
+		actor.Admin = true
+	}
+	return actor, nil
+}
+
+func (c *Controller) mapDomainProfileToAPI(p domain.UserProfile) api.UserProfile {
+	return api.UserProfile{
+		UserId:            p.ID,
+		Username:          p.Username,
+		TeamName:          p.TeamName,
+		Teams:             c.mapMembershipsToAPI(p.Teams),
+		IsActive:          p.IsActive,
+		OpenReviewCount:   p.OpenReviewCount,
+		OpenAuthoredCount: p.OpenAuthoredCount,
+		WorkingHours: api.WorkingHours{
+			Timezone: p.WorkingHours.Timezone,
+			Start:    domain.FormatClock(p.WorkingHours.Start),
+			End:      domain.FormatClock(p.WorkingHours.End),
+		},
+	}
+}
+
+func (c *Controller) mapMembershipsToAPI(memberships []domain.Membership) []api.TeamMembership {
+	result := make([]api.TeamMembership, len(memberships))
+	for i, m := range memberships {
+		result[i] = api.TeamMembership{
+			TeamName: m.TeamName,
+			Role:     api.TeamRole(m.Role),
+			Primary:  m.Primary,
+		}
+	}
+	return result
+}
+
+func (c *Controller) optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func (c *Controller) mapDomainTeamSettingsToAPI(settings domain.TeamSettings) api.TeamSettings {
+	return api.TeamSettings{
+		ReassignOnDeactivate: settings.ReassignOnDeactivate,
+		ReviewerSelection:    api.TeamSettingsReviewerSelection(settings.ReviewerSelection),
+		ReviewSlaHours:       settings.ReviewSLAHours,
+		ReviewerCount:        settings.ReviewerCount,
+		EscalateToParent:     settings.EscalateToParent,
+		NoCandidatePolicy:    api.TeamSettingsNoCandidatePolicy(settings.NoCandidatePolicy),
+		MergePolicy:          api.TeamSettingsMergePolicy(settings.MergePolicy),
+	}
+}
+
+func (c *Controller) mapDomainTeamOverridesToAPI(o domain.TeamSettingsOverrides) api.TeamSettingsOverrides {
+	return api.TeamSettingsOverrides{
+		ReassignOnDeactivate: o.ReassignOnDeactivate,
+		ReviewerSelection:    (*api.TeamSettingsOverridesReviewerSelection)(o.ReviewerSelection),
+		ReviewSlaHours:       o.ReviewSLAHours,
+		ReviewerCount:        o.ReviewerCount,
+		EscalateToParent:     o.EscalateToParent,
+		NoCandidatePolicy:    (*api.TeamSettingsOverridesNoCandidatePolicy)(o.NoCandidatePolicy),
+		MergePolicy:          (*api.TeamSettingsOverridesMergePolicy)(o.MergePolicy),
+	}
+}
+
+func (c *Controller) mapDomainTeamToAPI(team domain.Team, members []api.TeamMember) api.Team {
+	settings := c.mapDomainTeamSettingsToAPI(team.Settings)
+	overrides := c.mapDomainTeamOverridesToAPI(team.Overrides)
+
+	return api.Team{
+		TeamName:       team.Name,
+		ParentTeamName: team.ParentName,
+		Members:        members,
+		Settings:       &settings,
+		Overrides:      &overrides,
+		ArchivedAt:     team.ArchivedAt,
+	}
+}
+
+func (c *Controller) mapDomainTeamNodesToAPI(nodes []domain.TeamNode) []api.TeamNode {
+	result := make([]api.TeamNode, len(nodes))
+	for i, n := range nodes {
+		overrides := c.mapDomainTeamOverridesToAPI(n.Overrides)
+		result[i] = api.TeamNode{
+			TeamName:       n.Name,
+			ParentTeamName: n.ParentName,
+			ArchivedAt:     n.ArchivedAt,
+			MemberCount:    n.MemberCount,
+			Settings:       c.mapDomainTeamSettingsToAPI(n.Settings),
+			Overrides:      &overrides,
+			Children:       c.mapDomainTeamNodesToAPI(n.Children),
+		}
+	}
+	return result
+}
+
+func (c *Controller) mapReassignmentsToAPI(reassigned []domain.Reassignment) []api.ReviewReassignment {
+	result := make([]api.ReviewReassignment, len(reassigned))
+	for i, ra := range reassigned {
+		result[i] = api.ReviewReassignment{
+			PullRequestId: ra.PullRequestID,
+			OldReviewerId: ra.OldReviewerID,
+			Outcome:       api.ReviewReassignmentOutcome(ra.Outcome),
+		}
+		if ra.NewReviewerID != "" {
+			newID := ra.NewReviewerID
+			result[i].NewReviewerId = &newID
+		}
+	}
+	return result
+}
+
+func (c *Controller) mapBackfillsToAPI(backfilled []domain.Backfill) []api.ReviewBackfill {
+	result := make([]api.ReviewBackfill, len(backfilled))
+	for i, b := range backfilled {
+		result[i] = api.ReviewBackfill{
+			PullRequestId:  b.PullRequestID,
+			TeamName:       b.TeamName,
+			AddedReviewers: b.ReviewerIDs,
+		}
+	}
+	return result
+}
+
+func (c *Controller) mapPageRequest(limit *api.LimitQuery, cursor *api.CursorQuery, sortBy, order *string) (domain.PageRequest, error) {
+	var page domain.PageRequest
+	if limit != nil {
+		page.Limit = int(*limit)
+	}
+	if cursor != nil {
+		page.Cursor = string(*cursor)
+	}
+	if sortBy != nil {
+		page.SortBy = *sortBy
+	}
+	if order != nil {
+		switch *order {
+		case "asc":
+		case "desc":
+			page.Desc = true
+		default:
+			return domain.PageRequest{}, fmt.Errorf("%w: unknown order %q", domain.ErrInvalidInput, *order)
+		}
+	}
+	return page, nil
+}
+
+func (c *Controller) parseClock(s *string) (*int, error) {
+	if s == nil {
+		return nil, nil
+	}
+	minutes, err := domain.ParseClock(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &minutes, nil
+}
+
 func (c *Controller) respondError(w http.ResponseWriter, err error) {
 	var code api.ErrorResponseErrorCode
 	var status int
@@ -44,22 +243,59 @@ func (c *Controller) respondError(w http.ResponseWriter, err error) {
 		code, status = api.PREXISTS, http.StatusConflict
 	case errors.Is(err, domain.ErrPRMerged):
 		code, status = api.PRMERGED, http.StatusConflict
+	case errors.Is(err, domain.ErrPRClosed):
+		code, status = api.PRCLOSED, http.StatusConflict
+	case errors.Is(err, domain.ErrMergeBlocked):
+		code, status = api.MERGEBLOCKED, http.StatusConflict
 	case errors.Is(err, domain.ErrNotAssigned):
 		code, status = api.NOTASSIGNED, http.StatusConflict
 	case errors.Is(err, domain.ErrNoCandidate):
 		code, status = api.NOCANDIDATE, http.StatusConflict
+	case errors.Is(err, domain.ErrMemberConflict):
+		code, status = api.MEMBERCONFLICT, http.StatusConflict
+	case errors.Is(err, domain.ErrHasOpenReviews):
+		code, status = api.HASOPENREVIEWS, http.StatusConflict
+	case errors.Is(err, domain.ErrTeamArchived):
+		code, status = api.TEAMARCHIVED, http.StatusConflict
+	case errors.Is(err, domain.ErrTeamNotEmpty):
+		code, status = api.TEAMNOTEMPTY, http.StatusConflict
+	case errors.Is(err, domain.ErrInvalidInput):
+		code, status = api.INVALIDINPUT, http.StatusBadRequest
+	case errors.Is(err, domain.ErrUnauthorized), errors.Is(err, domain.ErrBadSignature):
+		code, status = api.UNAUTHORIZED, http.StatusUnauthorized
+	case errors.Is(err, domain.ErrForbidden), errors.Is(err, domain.ErrAdminOnly):
+		code, status = api.FORBIDDEN, http.StatusForbidden
 	default:
 		code, status = "INTERNAL_ERROR", http.StatusInternalServerError
 	}
 
-	resp := api.ErrorResponse{
-		Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{
-			Code:    code,
-			Message: err.Error(),
-		},
+	var resp api.ErrorResponse
+	resp.Error.Code = code
+	resp.Error.Message = err.Error()
+
+	var conflictErr *domain.MemberConflictError
+	if errors.As(err, &conflictErr) {
+		conflicts := c.mapMemberConflictsToAPI(conflictErr.Conflicts)
+		resp.Error.Conflicts = &conflicts
 	}
 	c.respondJSON(w, status, resp)
 }
+
+func (c *Controller) mapMemberConflictsToAPI(conflicts []domain.MemberConflict) []api.MemberConflict {
+	result := make([]api.MemberConflict, len(conflicts))
+	for i, mc := range conflicts {
+		result[i] = api.MemberConflict{
+			UserId:          mc.UserID,
+			Reason:          api.MemberConflictReason(mc.Reason),
+			CurrentTeamName: c.optionalString(mc.TeamName),
+		}
+	}
+	return result
+}
+
+func (c *Controller) nextCursor(next string) *string {
+	if next == "" {
+		return nil
+	}
+	return &next
+}
@@ -0,0 +1,176 @@
+package http
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/integration/github"
+	"avito-test-task/internal/integration/gitlab"
+	"avito-test-task/pkg/api"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+)
+
+// maxWebhookBody is the largest delivery accepted; GitHub caps payloads at 25 MB
+// and GitLab's default limit is the same.
+const maxWebhookBody = 25 << 20
+
+func (c *Controller) PostIntegrationsSetUserMapping(w http.ResponseWriter, r *http.Request, params api.PostIntegrationsSetUserMappingParams) {
+	var body api.PostIntegrationsSetUserMappingJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	userID := ""
+	if body.UserId != nil {
+		userID = *body.UserId
+	}
+
+	actor, err := c.actor(nil, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	if err := c.service.SetExternalUser(r.Context(), actor, domain.Provider(body.Provider), body.Login, userID); err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *Controller) PostIntegrationsSetTeamMapping(w http.ResponseWriter, r *http.Request, params api.PostIntegrationsSetTeamMappingParams) {
+	var body api.PostIntegrationsSetTeamMappingJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	teamName := ""
+	if body.TeamName != nil {
+		teamName = *body.TeamName
+	}
+
+	actor, err := c.actor(nil, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	if err := c.service.SetExternalTeam(r.Context(), actor, domain.Provider(body.Provider), body.ExternalTeam, teamName); err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *Controller) GetIntegrationsMappings(w http.ResponseWriter, r *http.Request, params api.GetIntegrationsMappingsParams) {
+	users, teams, err := c.service.ListExternalMappings(r.Context(), domain.Provider(params.Provider))
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	apiUsers := make([]api.ExternalUser, len(users))
+	for i, u := range users {
+		apiUsers[i] = api.ExternalUser{Provider: api.Provider(u.Provider), Login: u.Login, UserId: u.UserID}
+	}
+	apiTeams := make([]api.ExternalTeam, len(teams))
+	for i, t := range teams {
+		apiTeams[i] = api.ExternalTeam{Provider: api.Provider(t.Provider), ExternalTeam: t.ExternalTeam, TeamName: t.TeamName}
+	}
+
+	response := struct {
+		Users []api.ExternalUser `json:"users"`
+		Teams []api.ExternalTeam `json:"teams"`
+	}{
+		Users: apiUsers,
+		Teams: apiTeams,
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostWebhooksGithub(w http.ResponseWriter, r *http.Request, params api.PostWebhooksGithubParams) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	signature := ""
+	if params.XHubSignature256 != nil {
+		signature = *params.XHubSignature256
+	}
+	if !github.VerifySignature(c.cfg.GitHubWebhookSecret, payload, signature) {
+		c.respondError(w, domain.ErrBadSignature)
+		return
+	}
+
+	event, ok, err := github.ParseEvent(params.XGitHubEvent, payload)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+	c.respondWebhookEvent(w, r, event, ok)
+}
+
+func (c *Controller) PostWebhooksGitlab(w http.ResponseWriter, r *http.Request, params api.PostWebhooksGitlabParams) {
+	token := ""
+	if params.XGitlabToken != nil {
+		token = *params.XGitlabToken
+	}
+	if !gitlab.VerifyToken(c.cfg.GitLabWebhookToken, token) {
+		c.respondError(w, domain.ErrBadSignature)
+		return
+	}
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	event, ok, err := gitlab.ParseEvent(params.XGitlabEvent, payload)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+	c.respondWebhookEvent(w, r, event, ok)
+}
+
+// respondWebhookEvent applies a parsed code host event. Events the service does
+// not track, those about unmapped authors or unknown pull requests, and pull
+// requests that cannot be opened because their team is archived or has no one
+// to review them are acknowledged as ignored: GitLab disables hooks that keep
+// failing, and one unmapped user must not stop the sync of a whole project.
+func (c *Controller) respondWebhookEvent(w http.ResponseWriter, r *http.Request, event domain.ExternalPREvent, ok bool) {
+	type webhookResponse struct {
+		Result string           `json:"result"`
+		Reason *string          `json:"reason,omitempty"`
+		Pr     *api.PullRequest `json:"pr,omitempty"`
+	}
+
+	if !ok {
+		c.respondJSON(w, http.StatusOK, webhookResponse{Result: "ignored"})
+		return
+	}
+
+	pr, err := c.service.SyncExternalPullRequest(r.Context(), event)
+	if errors.Is(err, domain.ErrNotFound) || errors.Is(err, domain.ErrNoCandidate) || errors.Is(err, domain.ErrTeamArchived) {
+		log.Printf("Ignored %s event for %s: %v\n", event.Provider, event.PullRequestID, err)
+		reason := err.Error()
+		c.respondJSON(w, http.StatusOK, webhookResponse{Result: "ignored", Reason: &reason})
+		return
+	}
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	apiPR := c.mapDomainPRToAPI(pr)
+	c.respondJSON(w, http.StatusOK, webhookResponse{Result: string(event.Action), Pr: &apiPR})
+}
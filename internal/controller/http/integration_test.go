@@ -0,0 +1,259 @@
+package http
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/integration/github"
+	"avito-test-task/internal/service"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+const (
+	testGitHubSecret = "gh-secret"
+	testGitLabToken  = "gl-token"
+	testAdminToken   = "admin-token"
+)
+
+// fakeService embeds service.Service, so a test fails loudly with a nil
+// dereference if the controller reaches a method it was not meant to call.
+type fakeService struct {
+	service.Service
+
+	syncErr error
+	events  []domain.ExternalPREvent
+	actors  []domain.Actor
+}
+
+func (s *fakeService) SyncExternalPullRequest(_ context.Context, event domain.ExternalPREvent) (domain.PullRequest, error) {
+	s.events = append(s.events, event)
+	if s.syncErr != nil {
+		return domain.PullRequest{}, s.syncErr
+	}
+	return domain.PullRequest{ID: event.PullRequestID, Name: event.Title, AuthorID: "alice", Status: domain.PRStatusOpen, Reviewers: []string{"bob"}}, nil
+}
+
+// SetExternalUser and SetExternalTeam check the actor like the real service.
+func (s *fakeService) SetExternalUser(_ context.Context, actor domain.Actor, _ domain.Provider, _, _ string) error {
+	s.actors = append(s.actors, actor)
+	if !actor.Admin {
+		return domain.ErrAdminOnly
+	}
+	return nil
+}
+
+func (s *fakeService) SetExternalTeam(_ context.Context, actor domain.Actor, _ domain.Provider, _, _ string) error {
+	s.actors = append(s.actors, actor)
+	if !actor.Admin {
+		return domain.ErrAdminOnly
+	}
+	return nil
+}
+
+func newTestController(s *fakeService) http.Handler {
+	return NewController(s, Config{
+		GitHubWebhookSecret: testGitHubSecret,
+		GitLabWebhookToken:  testGitLabToken,
+		AdminToken:          testAdminToken,
+	}).Handler
+}
+
+// readDelivery returns a delivery recorded for the parser tests of a provider.
+func readDelivery(t *testing.T, provider, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile("../../integration/" + provider + "/testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+type webhookResult struct {
+	Result string  `json:"result"`
+	Reason *string `json:"reason"`
+	Pr     *struct {
+		PullRequestId string `json:"pull_request_id"`
+	} `json:"pr"`
+}
+
+func serve(t *testing.T, h http.Handler, req *http.Request) (*httptest.ResponseRecorder, webhookResult) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var res webhookResult
+	if rec.Code == http.StatusOK && rec.Body.Len() > 0 {
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatalf("decode %q: %v", rec.Body.String(), err)
+		}
+	}
+	return rec, res
+}
+
+func githubRequest(eventType string, body []byte, signature string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/github", bytes.NewReader(body))
+	req.Header.Set("X-GitHub-Event", eventType)
+	if signature != "" {
+		req.Header.Set("X-Hub-Signature-256", signature)
+	}
+	return req
+}
+
+func gitlabRequest(eventType string, body []byte, token string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/gitlab", bytes.NewReader(body))
+	req.Header.Set("X-Gitlab-Event", eventType)
+	if token != "" {
+		req.Header.Set("X-Gitlab-Token", token)
+	}
+	return req
+}
+
+func TestWebhookRejectsUnverifiedDeliveries(t *testing.T) {
+	opened := readDelivery(t, "github", "pull_request.opened.json")
+	open := readDelivery(t, "gitlab", "merge_request.open.json")
+
+	tests := []struct {
+		name string
+		req  *http.Request
+	}{
+		{name: "github without signature", req: githubRequest("pull_request", opened, "")},
+		{name: "github signed with another secret", req: githubRequest("pull_request", opened, github.Sign("other", opened))},
+		{name: "gitlab without token", req: gitlabRequest("Merge Request Hook", open, "")},
+		{name: "gitlab with a wrong token", req: gitlabRequest("Merge Request Hook", open, "guess")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeService{}
+			rec, _ := serve(t, newTestController(svc), tt.req)
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+			}
+			if len(svc.events) != 0 {
+				t.Errorf("synced %d events, want none", len(svc.events))
+			}
+		})
+	}
+}
+
+func TestWebhookAppliesEvents(t *testing.T) {
+	opened := readDelivery(t, "github", "pull_request.opened.json")
+	open := readDelivery(t, "gitlab", "merge_request.open.json")
+
+	tests := []struct {
+		name   string
+		req    *http.Request
+		wantID string
+	}{
+		{name: "github", req: githubRequest("pull_request", opened, github.Sign(testGitHubSecret, opened)), wantID: "acme/payments#42"},
+		{name: "gitlab", req: gitlabRequest("Merge Request Hook", open, testGitLabToken), wantID: "finance/ledger/ledger-api!17"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeService{}
+			rec, res := serve(t, newTestController(svc), tt.req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+			}
+			if res.Result != string(domain.ExternalPROpened) || res.Pr == nil || res.Pr.PullRequestId != tt.wantID {
+				t.Errorf("response = %s, want %s of %s", rec.Body, domain.ExternalPROpened, tt.wantID)
+			}
+		})
+	}
+}
+
+// Deliveries the service cannot apply are acknowledged, so that the code host
+// keeps the hook enabled.
+func TestWebhookIgnoresEvents(t *testing.T) {
+	opened := readDelivery(t, "github", "pull_request.opened.json")
+	edited := readDelivery(t, "github", "pull_request.edited.json")
+
+	tests := []struct {
+		name       string
+		eventType  string
+		body       []byte
+		syncErr    error
+		wantReason bool
+	}{
+		{name: "untracked action", eventType: "pull_request", body: edited},
+		{name: "untracked event", eventType: "ping", body: readDelivery(t, "github", "ping.json")},
+		{name: "unmapped author", eventType: "pull_request", body: opened, syncErr: domain.ErrNotFound, wantReason: true},
+		{name: "no reviewer", eventType: "pull_request", body: opened, syncErr: domain.ErrNoCandidate, wantReason: true},
+		{name: "archived team", eventType: "pull_request", body: opened, syncErr: domain.ErrTeamArchived, wantReason: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeService{syncErr: tt.syncErr}
+			req := githubRequest(tt.eventType, tt.body, github.Sign(testGitHubSecret, tt.body))
+			rec, res := serve(t, newTestController(svc), req)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+			}
+			if res.Result != "ignored" || res.Pr != nil {
+				t.Errorf("response = %s, want ignored", rec.Body)
+			}
+			if hasReason := res.Reason != nil && *res.Reason != ""; hasReason != tt.wantReason {
+				t.Errorf("reason = %v, want one: %v", res.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestWebhookReportsMergedConflict(t *testing.T) {
+	opened := readDelivery(t, "github", "pull_request.opened.json")
+	svc := &fakeService{syncErr: domain.ErrPRMerged}
+
+	rec, _ := serve(t, newTestController(svc), githubRequest("pull_request", opened, github.Sign(testGitHubSecret, opened)))
+	if rec.Code != http.StatusConflict {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+}
+
+func TestSetMappingRequiresAdminToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		adminToken  string
+		wantStatus  int
+		wantReached bool
+	}{
+		{name: "no admin token", wantStatus: http.StatusForbidden, wantReached: true},
+		{name: "wrong admin token", adminToken: "guess", wantStatus: http.StatusUnauthorized},
+		{name: "admin token", adminToken: testAdminToken, wantStatus: http.StatusOK, wantReached: true},
+	}
+
+	routes := []struct {
+		path string
+		body string
+	}{
+		{path: "/integrations/setUserMapping", body: `{"provider":"github","login":"bob-dev","user_id":"bob"}`},
+		{path: "/integrations/setTeamMapping", body: `{"provider":"github","external_team":"platform","team_name":"backend"}`},
+	}
+
+	for _, route := range routes {
+		for _, tt := range tests {
+			t.Run(route.path+"/"+tt.name, func(t *testing.T) {
+				svc := &fakeService{}
+				req := httptest.NewRequest(http.MethodPost, route.path, bytes.NewBufferString(route.body))
+				if tt.adminToken != "" {
+					req.Header.Set("X-Admin-Token", tt.adminToken)
+				}
+				rec := httptest.NewRecorder()
+				newTestController(svc).ServeHTTP(rec, req)
+
+				if rec.Code != tt.wantStatus {
+					t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+				}
+				// A wrong token is rejected before the service is asked.
+				if reached := len(svc.actors) > 0; reached != tt.wantReached {
+					t.Errorf("service reached = %v, want %v", reached, tt.wantReached)
+				}
+			})
+		}
+	}
+}
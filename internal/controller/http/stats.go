@@ -0,0 +1,158 @@
+package http
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/pkg/api"
+	"net/http"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (c *Controller) GetStatsReviewers(w http.ResponseWriter, r *http.Request, params api.GetStatsReviewersParams) {
+	teamName := ""
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+
+	stats, err := c.service.GetReviewerStats(r.Context(), teamName, c.statsWindow(params.From, params.To))
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	users := make([]api.UserReviewStats, len(stats.Users))
+	for i, u := range stats.Users {
+		users[i] = api.UserReviewStats{
+			UserId:         u.UserID,
+			Username:       u.Username,
+			Assignments:    u.Assignments,
+			ReassignedAway: u.ReassignedAway,
+			ReassignedIn:   u.ReassignedIn,
+			MergedReviewed: u.MergedReviewed,
+			OpenLoad:       u.OpenLoad,
+		}
+	}
+	teams := make([]api.TeamReviewStats, len(stats.Teams))
+	for i, t := range stats.Teams {
+		teams[i] = api.TeamReviewStats{
+			TeamName:       t.TeamName,
+			Assignments:    t.Assignments,
+			ReassignedAway: t.ReassignedAway,
+			ReassignedIn:   t.ReassignedIn,
+			MergedReviewed: t.MergedReviewed,
+			OpenLoad:       t.OpenLoad,
+		}
+	}
+
+	response := struct {
+		Users []api.UserReviewStats `json:"users"`
+		Teams []api.TeamReviewStats `json:"teams"`
+	}{
+		Users: users,
+		Teams: teams,
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) GetStatsTeam(w http.ResponseWriter, r *http.Request, params api.GetStatsTeamParams) {
+	stats, err := c.service.GetTeamDeliveryStats(r.Context(), string(params.TeamName), c.statsWindow(params.From, params.To))
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	throughput := make([]api.WeeklyThroughput, len(stats.Throughput))
+	for i, t := range stats.Throughput {
+		throughput[i] = api.WeeklyThroughput{
+			WeekStart: openapi_types.Date{Time: t.WeekStart},
+			Merged:    t.Merged,
+		}
+	}
+
+	response := struct {
+		TeamName            string                  `json:"team_name"`
+		TimeToMerge         api.DurationPercentiles `json:"time_to_merge"`
+		TimeToFirstDecision api.DurationPercentiles `json:"time_to_first_decision"`
+		Assignments         int                     `json:"assignments"`
+		Reassignments       int                     `json:"reassignments"`
+		ReassignmentRate    float64                 `json:"reassignment_rate"`
+		Throughput          []api.WeeklyThroughput  `json:"throughput"`
+	}{
+		TeamName:            stats.TeamName,
+		TimeToMerge:         c.mapDurationPercentilesToAPI(stats.TimeToMerge),
+		TimeToFirstDecision: c.mapDurationPercentilesToAPI(stats.TimeToFirstDecision),
+		Assignments:         stats.Assignments,
+		Reassignments:       stats.Reassignments,
+		ReassignmentRate:    stats.ReassignmentRate,
+		Throughput:          throughput,
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) mapDurationPercentilesToAPI(p domain.DurationPercentiles) api.DurationPercentiles {
+	result := api.DurationPercentiles{Count: p.Count}
+	if p.Count > 0 {
+		p50, p90, p99 := p.P50.Seconds(), p.P90.Seconds(), p.P99.Seconds()
+		result.P50Seconds, result.P90Seconds, result.P99Seconds = &p50, &p90, &p99
+	}
+	return result
+}
+
+func (c *Controller) GetStatsFairness(w http.ResponseWriter, r *http.Request, params api.GetStatsFairnessParams) {
+	teamName := ""
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+	threshold := domain.DefaultGiniThreshold
+	if params.GiniThreshold != nil {
+		threshold = *params.GiniThreshold
+	}
+
+	report, err := c.service.GetFairnessReport(r.Context(), teamName, c.statsWindow(params.From, params.To), threshold)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	teams := make([]api.TeamFairness, len(report))
+	for i, f := range report {
+		members := make([]api.MemberLoad, len(f.Members))
+		for j, m := range f.Members {
+			members[j] = api.MemberLoad{
+				UserId:         m.UserID,
+				IsActive:       m.IsActive,
+				Assignments:    m.Assignments,
+				AvailableShare: m.AvailableShare,
+			}
+		}
+		teams[i] = api.TeamFairness{
+			TeamName:   f.TeamName,
+			Members:    members,
+			Raw:        c.mapLoadDistributionToAPI(f.Raw),
+			Adjusted:   c.mapLoadDistributionToAPI(f.Adjusted),
+			Imbalanced: f.Imbalanced,
+		}
+	}
+
+	response := struct {
+		Teams []api.TeamFairness `json:"teams"`
+	}{
+		Teams: teams,
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) mapLoadDistributionToAPI(d domain.LoadDistribution) api.LoadDistribution {
+	return api.LoadDistribution{
+		Members:     d.Members,
+		Mean:        d.Mean,
+		StdDev:      d.StdDev,
+		Gini:        d.Gini,
+		MaxMinRatio: d.MaxMinRatio,
+	}
+}
+
+func (c *Controller) statsWindow(from *api.StatsFromQuery, to *api.StatsToQuery) domain.StatsWindow {
+	return domain.StatsWindow{From: (*time.Time)(from), To: (*time.Time)(to)}
+}
@@ -0,0 +1,189 @@
+package http
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/pkg/api"
+	"encoding/json"
+	"net/http"
+)
+
+func (c *Controller) PostSubscriptionsAdd(w http.ResponseWriter, r *http.Request, params api.PostSubscriptionsAddParams) {
+	var body api.PostSubscriptionsAddJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	sub := domain.WebhookSubscription{URL: body.Url, Events: make([]domain.EventType, len(body.Events))}
+	for i, e := range body.Events {
+		sub.Events[i] = domain.EventType(e)
+	}
+	if body.Secret != nil {
+		sub.Secret = *body.Secret
+	}
+
+	actor, err := c.actor(nil, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	created, err := c.service.CreateWebhookSubscription(r.Context(), actor, sub)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		Subscription api.WebhookSubscription `json:"subscription"`
+		Secret       string                  `json:"secret"`
+	}{
+		Subscription: c.mapDomainSubscriptionToAPI(created),
+		Secret:       created.Secret,
+	}
+	c.respondJSON(w, http.StatusCreated, response)
+}
+
+func (c *Controller) GetSubscriptionsList(w http.ResponseWriter, r *http.Request, params api.GetSubscriptionsListParams) {
+	actor, err := c.actor(nil, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	subs, err := c.service.ListWebhookSubscriptions(r.Context(), actor)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	apiSubs := make([]api.WebhookSubscription, len(subs))
+	for i, s := range subs {
+		apiSubs[i] = c.mapDomainSubscriptionToAPI(s)
+	}
+
+	response := struct {
+		Subscriptions []api.WebhookSubscription `json:"subscriptions"`
+	}{
+		Subscriptions: apiSubs,
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) DeleteSubscriptions(w http.ResponseWriter, r *http.Request, params api.DeleteSubscriptionsParams) {
+	actor, err := c.actor(nil, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	if err := c.service.DeleteWebhookSubscription(r.Context(), actor, int64(params.SubscriptionId)); err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *Controller) GetSubscriptionsDeliveries(w http.ResponseWriter, r *http.Request, params api.GetSubscriptionsDeliveriesParams) {
+	actor, err := c.actor(nil, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	page, err := c.mapPageRequest(params.Limit, params.Cursor, nil, nil)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	filter := domain.DeliveryFilter{SubscriptionID: params.SubscriptionId}
+	if params.Status != nil {
+		status := domain.DeliveryStatus(*params.Status)
+		filter.Status = &status
+	}
+
+	deliveries, next, err := c.service.ListWebhookDeliveries(r.Context(), actor, filter, page)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	apiDeliveries := make([]api.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		apiDeliveries[i] = c.mapDomainDeliveryToAPI(d)
+	}
+
+	response := struct {
+		Deliveries []api.WebhookDelivery `json:"deliveries"`
+		NextCursor *string               `json:"next_cursor"`
+	}{
+		Deliveries: apiDeliveries,
+		NextCursor: c.nextCursor(next),
+	}
+	c.respondJSON(w, http.StatusOK, response)
+}
+
+func (c *Controller) PostSubscriptionsRedeliver(w http.ResponseWriter, r *http.Request, params api.PostSubscriptionsRedeliverParams) {
+	var body api.PostSubscriptionsRedeliverJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	actor, err := c.actor(nil, params.XAdminToken)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	delivery, err := c.service.RedeliverWebhook(r.Context(), actor, body.DeliveryId)
+	if err != nil {
+		c.respondError(w, err)
+		return
+	}
+
+	response := struct {
+		Delivery api.WebhookDelivery `json:"delivery"`
+	}{
+		Delivery: c.mapDomainDeliveryToAPI(delivery),
+	}
+	c.respondJSON(w, http.StatusCreated, response)
+}
+
+func (c *Controller) mapDomainSubscriptionToAPI(s domain.WebhookSubscription) api.WebhookSubscription {
+	events := make([]api.WebhookEventType, len(s.Events))
+	for i, e := range s.Events {
+		events[i] = api.WebhookEventType(e)
+	}
+	return api.WebhookSubscription{
+		SubscriptionId: s.ID,
+		Url:            s.URL,
+		Events:         events,
+		CreatedAt:      s.CreatedAt,
+	}
+}
+
+func (c *Controller) mapDomainDeliveryToAPI(d domain.WebhookDelivery) api.WebhookDelivery {
+	// The payload was built by the events package, so it always decodes.
+	var payload api.WebhookEvent
+	_ = json.Unmarshal(d.Payload, &payload)
+
+	return api.WebhookDelivery{
+		DeliveryId:     d.ID,
+		SubscriptionId: d.SubscriptionID,
+		EventId:        d.EventID,
+		Event:          api.WebhookEventType(d.Event),
+		Payload:        payload,
+		Status:         api.WebhookDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		RedeliveryOf:   d.RedeliveryOf,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
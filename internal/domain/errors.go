@@ -1,12 +1,40 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
-	ErrNotFound    = errors.New("resource not found")
-	ErrTeamExists  = errors.New("team already exists")
-	ErrPRExists    = errors.New("pull request already exists")
-	ErrPRMerged    = errors.New("pull request is already merged")
-	ErrNotAssigned = errors.New("user is not assigned as a reviewer")
-	ErrNoCandidate = errors.New("no active candidates available for review")
+	ErrNotFound       = errors.New("resource not found")
+	ErrTeamExists     = errors.New("team already exists")
+	ErrPRExists       = errors.New("pull request already exists")
+	ErrPRMerged       = errors.New("pull request is already merged")
+	ErrPRClosed       = errors.New("pull request is closed")
+	ErrMergeBlocked   = errors.New("pull request does not meet the team's merge policy")
+	ErrNotAssigned    = errors.New("user is not assigned as a reviewer")
+	ErrNoCandidate    = errors.New("no active candidates available for review")
+	ErrMemberConflict = errors.New("user already belongs to another team")
+	ErrHasOpenReviews = errors.New("user still has open reviews")
+	ErrTeamArchived   = errors.New("team is archived")
+	ErrTeamNotEmpty   = errors.New("team still has members")
+	ErrInvalidInput   = errors.New("invalid input")
+	ErrUnauthorized   = errors.New("acting user is not identified")
+	ErrForbidden      = errors.New("action requires a team lead")
+	ErrAdminOnly      = errors.New("action requires the admin token")
+	ErrBadSignature   = errors.New("webhook signature or token does not match")
 )
+
+// MemberConflictError lists the members that made a team payload fail.
+// It matches ErrMemberConflict with errors.Is.
+type MemberConflictError struct {
+	Conflicts []MemberConflict
+}
+
+func (e *MemberConflictError) Error() string {
+	return fmt.Sprintf("%s: %d conflicting members", ErrMemberConflict, len(e.Conflicts))
+}
+
+func (e *MemberConflictError) Unwrap() error {
+	return ErrMemberConflict
+}
@@ -0,0 +1,47 @@
+package domain
+
+// EventType names a domain event that webhook subscriptions can filter on.
+type EventType string
+
+const (
+	EventPRCreated          EventType = "pr.created"
+	EventReviewerAssigned   EventType = "reviewer.assigned"
+	EventReviewerReassigned EventType = "reviewer.reassigned"
+	EventPRMerged           EventType = "pr.merged"
+	EventUserDeactivated    EventType = "user.deactivated"
+)
+
+func (t EventType) Valid() bool {
+	switch t {
+	case EventPRCreated, EventReviewerAssigned, EventReviewerReassigned, EventPRMerged, EventUserDeactivated:
+		return true
+	}
+	return false
+}
+
+// Reasons carried by reviewer.assigned events.
+const (
+	AssignCreated    = "created"
+	AssignBackfill   = "backfill"
+	AssignEscalation = "escalation"
+)
+
+// Reasons carried by reviewer.reassigned events and reported to the
+// reassignment metrics.
+const (
+	ReassignManual       = "manual"
+	ReassignDeactivation = "deactivation"
+	ReassignMembership   = "membership"
+	ReassignAbsence      = "absence"
+)
+
+// Event is a domain event recorded in the outbox together with the change it
+// describes. Payload is the JSON body published to sinks; it already carries
+// ID and Type. Events with the same Key, the pull request or, for user events,
+// the user, are published in the order they were committed.
+type Event struct {
+	ID      string
+	Type    EventType
+	Key     string
+	Payload []byte
+}
@@ -0,0 +1,65 @@
+package domain
+
+import "iter"
+
+const (
+	DefaultExportPageLimit = 10000
+	MaxExportPageLimit     = 100000
+)
+
+// ExportDataset is a table of analytics data that can be exported.
+type ExportDataset string
+
+const (
+	// ExportPullRequests has one row per pull request, by creation time.
+	ExportPullRequests ExportDataset = "pull_requests"
+	// ExportAssignments has one row per current reviewer slot, by pull request.
+	ExportAssignments ExportDataset = "assignments"
+	// ExportDecisions has one row per reviewer decision, by pull request.
+	ExportDecisions ExportDataset = "decisions"
+	// ExportEvents is the review history log, in the order it was written.
+	ExportEvents ExportDataset = "events"
+)
+
+func (d ExportDataset) Valid() bool {
+	return d == ExportPullRequests || d == ExportAssignments || d == ExportDecisions || d == ExportEvents
+}
+
+// ExportColumnType is the type of the values of an export column. Values are
+// string, time.Time or int64 respectively, or nil for NULL.
+type ExportColumnType int
+
+const (
+	ExportString ExportColumnType = iota
+	ExportTime
+	ExportInt
+)
+
+type ExportColumn struct {
+	Name string
+	Type ExportColumnType
+	// Personal columns hold user ids or usernames and are pseudonymized on request.
+	Personal bool
+}
+
+// ExportRequest asks for one page of a dataset. Rows are limited to those whose
+// time falls in Window. Cursor is the opaque value returned with the previous
+// page; it pins the export to the moment the first page was taken, so rows
+// added later do not shift the pages.
+type ExportRequest struct {
+	Dataset      ExportDataset
+	Window       StatsWindow
+	Pseudonymize bool
+	Limit        int
+	Cursor       string
+}
+
+// ExportPage is a page of an export. Rows reads the page from the database as
+// it is ranged over, so a page is never held in memory; it can be ranged over
+// once. NextCursor is empty on the last page.
+type ExportPage struct {
+	Dataset    ExportDataset
+	Columns    []ExportColumn
+	Rows       iter.Seq2[[]any, error]
+	NextCursor string
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"math"
+	"sort"
+)
+
+// DefaultGiniThreshold is the Gini coefficient of adjusted review load above
+// which a team is reported as imbalanced.
+const DefaultGiniThreshold = 0.3
+
+// MemberLoad is the number of reviews a team member was assigned on the team's
+// pull requests within a window. AvailableShare is the part of the window the
+// member was active and not absent, from 0 to 1.
+type MemberLoad struct {
+	UserID         string
+	IsActive       bool
+	Assignments    int
+	AvailableShare float64
+}
+
+// LoadDistribution describes how evenly values are spread. MaxMinRatio is nil
+// when the smallest value is zero.
+type LoadDistribution struct {
+	Members     int
+	Mean        float64
+	StdDev      float64
+	Gini        float64
+	MaxMinRatio *float64
+}
+
+// MinAvailableShare is the least part of the window a member must have been
+// available to count in the adjusted distribution; scaling up a load seen over
+// a few hours would only add noise.
+const MinAvailableShare = 0.1
+
+// TeamFairness compares review load within a team. Raw covers all members with
+// their plain assignment counts. Adjusted scales each count to the member's
+// available time, so absences and deactivation do not count as imbalance, and
+// leaves out members who were hardly available.
+type TeamFairness struct {
+	TeamName   string
+	Members    []MemberLoad
+	Raw        LoadDistribution
+	Adjusted   LoadDistribution
+	Imbalanced bool
+}
+
+// NewTeamFairness computes both distributions of the members' load and flags
+// the team when the adjusted Gini coefficient exceeds giniThreshold.
+func NewTeamFairness(teamName string, members []MemberLoad, giniThreshold float64) TeamFairness {
+	raw := make([]float64, 0, len(members))
+	adjusted := make([]float64, 0, len(members))
+	for _, m := range members {
+		raw = append(raw, float64(m.Assignments))
+		if m.AvailableShare >= MinAvailableShare {
+			adjusted = append(adjusted, float64(m.Assignments)/m.AvailableShare)
+		}
+	}
+
+	f := TeamFairness{
+		TeamName: teamName,
+		Members:  members,
+		Raw:      Distribution(raw),
+		Adjusted: Distribution(adjusted),
+	}
+	f.Imbalanced = f.Adjusted.Members > 1 && f.Adjusted.Gini > giniThreshold
+	return f
+}
+
+// Distribution computes the spread statistics of non-negative values.
+func Distribution(values []float64) LoadDistribution {
+	d := LoadDistribution{Members: len(values)}
+	if len(values) == 0 {
+		return d
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, v := range sorted {
+		sum += v
+	}
+	n := float64(len(sorted))
+	d.Mean = sum / n
+
+	var variance, weighted float64
+	for i, v := range sorted {
+		variance += (v - d.Mean) * (v - d.Mean)
+		weighted += float64(i+1) * v
+	}
+	d.StdDev = math.Sqrt(variance / n)
+
+	if sum > 0 {
+		d.Gini = 2*weighted/(n*sum) - (n+1)/n
+	}
+	if lowest := sorted[0]; lowest > 0 {
+		ratio := sorted[len(sorted)-1] / lowest
+		d.MaxMinRatio = &ratio
+	}
+	return d
+}
@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistribution(t *testing.T) {
+	ratio := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name   string
+		values []float64
+		want   LoadDistribution
+	}{
+		{
+			name:   "empty",
+			values: nil,
+			want:   LoadDistribution{},
+		},
+		{
+			name:   "all zeros",
+			values: []float64{0, 0, 0},
+			want:   LoadDistribution{Members: 3},
+		},
+		{
+			name:   "single member",
+			values: []float64{7},
+			want:   LoadDistribution{Members: 1, Mean: 7, MaxMinRatio: ratio(1)},
+		},
+		{
+			name:   "equal load",
+			values: []float64{4, 4, 4, 4},
+			want:   LoadDistribution{Members: 4, Mean: 4, MaxMinRatio: ratio(1)},
+		},
+		{
+			name:   "one member takes everything",
+			values: []float64{0, 0, 0, 8},
+			want:   LoadDistribution{Members: 4, Mean: 2, StdDev: math.Sqrt(12), Gini: 0.75},
+		},
+		{
+			name:   "unsorted input",
+			values: []float64{3, 1, 2},
+			want:   LoadDistribution{Members: 3, Mean: 2, StdDev: math.Sqrt(2.0 / 3), Gini: 2.0 / 9, MaxMinRatio: ratio(3)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Distribution(tt.values)
+			if got.Members != tt.want.Members {
+				t.Errorf("Members = %d, want %d", got.Members, tt.want.Members)
+			}
+			assertClose(t, "Mean", got.Mean, tt.want.Mean)
+			assertClose(t, "StdDev", got.StdDev, tt.want.StdDev)
+			assertClose(t, "Gini", got.Gini, tt.want.Gini)
+			switch {
+			case tt.want.MaxMinRatio == nil && got.MaxMinRatio != nil:
+				t.Errorf("MaxMinRatio = %v, want nil", *got.MaxMinRatio)
+			case tt.want.MaxMinRatio != nil && got.MaxMinRatio == nil:
+				t.Errorf("MaxMinRatio = nil, want %v", *tt.want.MaxMinRatio)
+			case tt.want.MaxMinRatio != nil:
+				assertClose(t, "MaxMinRatio", *got.MaxMinRatio, *tt.want.MaxMinRatio)
+			}
+		})
+	}
+}
+
+func TestNewTeamFairness(t *testing.T) {
+	tests := []struct {
+		name           string
+		members        []MemberLoad
+		wantRawGini    float64
+		wantAdjusted   int
+		wantAdjGini    float64
+		wantImbalanced bool
+	}{
+		{
+			name: "no members",
+		},
+		{
+			name:         "single member is never imbalanced",
+			members:      []MemberLoad{{UserID: "u1", IsActive: true, Assignments: 5, AvailableShare: 1}},
+			wantAdjusted: 1,
+		},
+		{
+			name: "load is weighted by active time",
+			members: []MemberLoad{
+				{UserID: "u1", IsActive: true, Assignments: 10, AvailableShare: 1},
+				{UserID: "u2", IsActive: false, Assignments: 5, AvailableShare: 0.5},
+			},
+			wantRawGini:  1.0 / 6,
+			wantAdjusted: 2,
+		},
+		{
+			name: "hardly available members are left out",
+			members: []MemberLoad{
+				{UserID: "u1", IsActive: true, Assignments: 6, AvailableShare: 1},
+				{UserID: "u2", IsActive: true, Assignments: 6, AvailableShare: 1},
+				{UserID: "u3", IsActive: false, Assignments: 0, AvailableShare: 0.05},
+			},
+			wantRawGini:  1.0 / 3,
+			wantAdjusted: 2,
+		},
+		{
+			name: "uneven load over the threshold",
+			members: []MemberLoad{
+				{UserID: "u1", IsActive: true, Assignments: 0, AvailableShare: 1},
+				{UserID: "u2", IsActive: true, Assignments: 0, AvailableShare: 1},
+				{UserID: "u3", IsActive: true, Assignments: 9, AvailableShare: 1},
+			},
+			wantRawGini:    2.0 / 3,
+			wantAdjusted:   3,
+			wantAdjGini:    2.0 / 3,
+			wantImbalanced: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewTeamFairness("backend", tt.members, DefaultGiniThreshold)
+			if got.Raw.Members != len(tt.members) {
+				t.Errorf("Raw.Members = %d, want %d", got.Raw.Members, len(tt.members))
+			}
+			if got.Adjusted.Members != tt.wantAdjusted {
+				t.Errorf("Adjusted.Members = %d, want %d", got.Adjusted.Members, tt.wantAdjusted)
+			}
+			assertClose(t, "Raw.Gini", got.Raw.Gini, tt.wantRawGini)
+			assertClose(t, "Adjusted.Gini", got.Adjusted.Gini, tt.wantAdjGini)
+			if got.Imbalanced != tt.wantImbalanced {
+				t.Errorf("Imbalanced = %v, want %v", got.Imbalanced, tt.wantImbalanced)
+			}
+		})
+	}
+}
+
+func assertClose(t *testing.T, field string, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("%s = %v, want %v", field, got, want)
+	}
+}
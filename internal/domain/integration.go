@@ -0,0 +1,52 @@
+package domain
+
+// Provider is a code host that pull requests are synced from.
+type Provider string
+
+const (
+	ProviderGitHub Provider = "github"
+	ProviderGitLab Provider = "gitlab"
+)
+
+func (p Provider) Valid() bool {
+	return p == ProviderGitHub || p == ProviderGitLab
+}
+
+// ExternalUser maps a code host login onto a user of the service.
+type ExternalUser struct {
+	Provider Provider
+	Login    string
+	UserID   string
+}
+
+// ExternalTeam maps a code host team onto a team of the service.
+type ExternalTeam struct {
+	Provider     Provider
+	ExternalTeam string
+	TeamName     string
+}
+
+// ExternalPRAction is what happened to a pull request on the code host.
+type ExternalPRAction string
+
+const (
+	ExternalPROpened   ExternalPRAction = "opened"
+	ExternalPRReopened ExternalPRAction = "reopened"
+	ExternalPRClosed   ExternalPRAction = "closed"
+	ExternalPRMerged   ExternalPRAction = "merged"
+	// ExternalPRUpdated carries the current title of a pull request that may
+	// not have been seen yet.
+	ExternalPRUpdated ExternalPRAction = "updated"
+)
+
+// ExternalPREvent is a pull request change reported by a code host webhook.
+// Teams are the code host teams involved in the pull request, in order of
+// preference for picking the team that owns it.
+type ExternalPREvent struct {
+	Provider      Provider
+	Action        ExternalPRAction
+	PullRequestID string
+	Title         string
+	AuthorLogin   string
+	Teams         []string
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 200
+)
+
+// PageRequest describes a single page of a cursor-paginated listing.
+// Cursor is the opaque value returned with the previous page.
+type PageRequest struct {
+	Limit  int
+	Cursor string
+	SortBy string
+	Desc   bool
+}
+
+type TeamFilter struct {
+	IncludeArchived bool
+}
+
+type TeamSummary struct {
+	Name        string
+	ArchivedAt  *time.Time
+	MemberCount int
+	ActiveCount int
+	OpenPRCount int
+}
+
+type UserFilter struct {
+	TeamName *string
+	IsActive *bool
+	Search   string
+}
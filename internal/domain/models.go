@@ -5,31 +5,420 @@ import (
 )
 
 type Team struct {
-	Name    string
-	Members []User
+	Name       string
+	ParentName *string
+	Members    []User
+	ArchivedAt *time.Time
+
+	// Overrides are the settings set on the team itself; Settings are the
+	// effective values after inheritance from parent teams.
+	Overrides TeamSettingsOverrides
+	Settings  TeamSettings
+}
+
+// ReviewerSelection is the strategy used to pick reviewers among available candidates.
+type ReviewerSelection string
+
+const (
+	// SelectionRandom picks candidates uniformly at random.
+	SelectionRandom ReviewerSelection = "random"
+	// SelectionWorkingHours prefers candidates who are within their working hours,
+	// then those whose working day starts soonest.
+	SelectionWorkingHours ReviewerSelection = "working_hours"
+)
+
+type TeamSettings struct {
+	// ReassignOnDeactivate is the default for moving a user's open reviews
+	// to other members when the user is deactivated.
+	ReassignOnDeactivate bool
+	ReviewerSelection    ReviewerSelection
+	// ReviewSLAHours is the review deadline in business hours of the reviewer; nil means no SLA.
+	ReviewSLAHours *int
+	ReviewerCount  int
+	// EscalateToParent lets reviewers be picked from the parent team's subtree
+	// when the team itself has no candidates.
+	EscalateToParent bool
+	// NoCandidatePolicy is applied to slots that bulk reassignment cannot hand
+	// over; it is either NoCandidatePark or NoCandidateRemove.
+	NoCandidatePolicy NoCandidatePolicy
+	MergePolicy       MergePolicy
+}
+
+var DefaultTeamSettings = TeamSettings{
+	ReassignOnDeactivate: false,
+	ReviewerSelection:    SelectionRandom,
+	ReviewerCount:        2,
+	EscalateToParent:     false,
+	NoCandidatePolicy:    NoCandidatePark,
+	MergePolicy:          MergeAny,
+}
+
+// TeamSettingsOverrides are the settings stored on a single team.
+// A nil field is inherited from the parent team. A zero ReviewSLAHours disables the SLA.
+type TeamSettingsOverrides struct {
+	ReassignOnDeactivate *bool
+	ReviewerSelection    *ReviewerSelection
+	ReviewSLAHours       *int
+	ReviewerCount        *int
+	EscalateToParent     *bool
+	NoCandidatePolicy    *NoCandidatePolicy
+	MergePolicy          *MergePolicy
+}
+
+// ResolveSettings computes effective settings from a chain of overrides that
+// starts with the team itself and ends with its root ancestor.
+func ResolveSettings(chain []TeamSettingsOverrides) TeamSettings {
+	s := DefaultTeamSettings
+	for i := len(chain) - 1; i >= 0; i-- {
+		o := chain[i]
+		if o.ReassignOnDeactivate != nil {
+			s.ReassignOnDeactivate = *o.ReassignOnDeactivate
+		}
+		if o.ReviewerSelection != nil {
+			s.ReviewerSelection = *o.ReviewerSelection
+		}
+		if o.ReviewSLAHours != nil {
+			s.ReviewSLAHours = o.ReviewSLAHours
+			if *o.ReviewSLAHours == 0 {
+				s.ReviewSLAHours = nil
+			}
+		}
+		if o.ReviewerCount != nil {
+			s.ReviewerCount = *o.ReviewerCount
+		}
+		if o.EscalateToParent != nil {
+			s.EscalateToParent = *o.EscalateToParent
+		}
+		if o.NoCandidatePolicy != nil {
+			s.NoCandidatePolicy = *o.NoCandidatePolicy
+		}
+		if o.MergePolicy != nil {
+			s.MergePolicy = *o.MergePolicy
+		}
+	}
+	return s
+}
+
+// Team setting names, used to reset an override back to inheritance.
+const (
+	SettingReassignOnDeactivate = "reassign_on_deactivate"
+	SettingReviewerSelection    = "reviewer_selection"
+	SettingReviewSLAHours       = "review_sla_hours"
+	SettingReviewerCount        = "reviewer_count"
+	SettingEscalateToParent     = "escalate_to_parent"
+	SettingNoCandidatePolicy    = "no_candidate_policy"
+	SettingMergePolicy          = "merge_policy"
+)
+
+// TeamSettingsUpdate changes the overrides of a team. Non-nil fields of Set are
+// stored; settings listed in Inherit are cleared so the parent's value applies.
+type TeamSettingsUpdate struct {
+	Set     TeamSettingsOverrides
+	Inherit []string
+}
+
+const MaxTeamNameLength = 255
+
+// TeamRename is the outcome of renaming a team.
+type TeamRename struct {
+	OldName        string
+	NewName        string
+	AliasExpiresAt time.Time
+}
+
+// TeamNode is a team in the hierarchy together with its sub-teams.
+type TeamNode struct {
+	Team
+	MemberCount int
+	Children    []TeamNode
+}
+
+// TeamRole is the role a user holds in their team.
+type TeamRole string
+
+const (
+	RoleMember TeamRole = "MEMBER"
+	// RoleLead may change team settings, deactivate members and reassign other
+	// people's reviews; overdue reviews are escalated to the lead.
+	RoleLead TeamRole = "LEAD"
+	// RoleMaintainer takes overdue reviews when the team has no available lead.
+	RoleMaintainer TeamRole = "MAINTAINER"
+)
+
+func (r TeamRole) Valid() bool {
+	return r == RoleMember || r == RoleLead || r == RoleMaintainer
+}
+
+// Actor is whoever performs an action. UserID is empty when the caller did not
+// identify themselves; Admin is set for callers holding the admin token, who
+// may act on any team, for example to appoint its first lead.
+type Actor struct {
+	UserID string
+	Admin  bool
 }
 
 type User struct {
 	ID       string
 	Username string
+	// TeamName is the user's primary team; see Membership for the others.
+	TeamName     string
+	Role         TeamRole
+	IsActive     bool
+	WorkingHours WorkingHours
+}
+
+// Membership is a team the user belongs to. Primary marks User.TeamName.
+type Membership struct {
 	TeamName string
-	IsActive bool
+	Role     TeamRole
+	Primary  bool
+}
+
+// UserProfile is a user together with their teams and current review workload.
+type UserProfile struct {
+	User
+	Teams             []Membership
+	OpenReviewCount   int
+	OpenAuthoredCount int
 }
 
+// UserUpdate holds a partial profile change; nil fields are left untouched.
+type UserUpdate struct {
+	Username  *string
+	Timezone  *string
+	WorkStart *int
+	WorkEnd   *int
+}
+
+const MaxUsernameLength = 255
+
 type PullRequestStatus string
 
 const (
 	PRStatusOpen   PullRequestStatus = "OPEN"
 	PRStatusMerged PullRequestStatus = "MERGED"
+	// PRStatusClosed is a pull request closed without merging; it can be reopened.
+	PRStatusClosed PullRequestStatus = "CLOSED"
 )
 
+type ReviewAssignment struct {
+	ReviewerID string
+	AssignedAt time.Time
+}
+
+// PullRequestSLA reports the review SLA clocks of every reviewer on a pull request.
+type PullRequestSLA struct {
+	PullRequestID  string
+	ReviewSLAHours *int
+	Reviewers      []ReviewerSLA
+}
+
+// ReviewerSLA is the state of a single reviewer's SLA clock on a pull request.
+// The clock only runs during the reviewer's working hours.
+type ReviewerSLA struct {
+	ReviewerID      string
+	AssignedAt      time.Time
+	BusinessElapsed time.Duration
+	DueAt           *time.Time
+	Overdue         bool
+}
+
 type PullRequest struct {
-	ID        string
-	Name      string
-	AuthorID  string
+	ID       string
+	Name     string
+	AuthorID string
+	// TeamName is the team that owns the PR and provides its reviewers.
+	TeamName  string
 	Status    PullRequestStatus
 	CreatedAt time.Time
 	MergedAt  *time.Time
+	ClosedAt  *time.Time
+	// FirstDecisionAt is when any reviewer first approved or requested changes.
+	FirstDecisionAt *time.Time
 
 	Reviewers []string
+	// RemovedSlots is how many reviewer slots were given up on reassignment;
+	// backfill does not refill them.
+	RemovedSlots int
+}
+
+// ReviewDecision is a reviewer's verdict on a pull request.
+type ReviewDecision string
+
+const (
+	DecisionApproved         ReviewDecision = "APPROVED"
+	DecisionChangesRequested ReviewDecision = "CHANGES_REQUESTED"
+)
+
+func (d ReviewDecision) Valid() bool {
+	return d == DecisionApproved || d == DecisionChangesRequested
+}
+
+// MergePolicy decides which review decisions a pull request needs before it
+// can be merged.
+type MergePolicy string
+
+const (
+	// MergeAny allows merging regardless of reviews.
+	MergeAny MergePolicy = "any"
+	// MergeApproved needs an approval and no requested changes.
+	MergeApproved MergePolicy = "approved"
+	// MergeAllApproved needs an approval from every reviewer.
+	MergeAllApproved MergePolicy = "all_approved"
+)
+
+func (p MergePolicy) Valid() bool {
+	return p == MergeAny || p == MergeApproved || p == MergeAllApproved
+}
+
+// Allows reports whether the reviewers' decisions let the pull request be
+// merged. An empty decision means the reviewer has not decided yet.
+func (p MergePolicy) Allows(decisions []ReviewDecision) bool {
+	approved := 0
+	for _, d := range decisions {
+		switch d {
+		case DecisionApproved:
+			approved++
+		case DecisionChangesRequested:
+			if p != MergeAny {
+				return false
+			}
+		}
+	}
+	switch p {
+	case MergeApproved:
+		return approved > 0
+	case MergeAllApproved:
+		return approved > 0 && approved == len(decisions)
+	}
+	return true
+}
+
+// NoCandidatePolicy decides what happens to a reviewer slot on reassignment
+// when nobody can take it over.
+type NoCandidatePolicy string
+
+const (
+	NoCandidateFail   NoCandidatePolicy = "fail"
+	NoCandidateRemove NoCandidatePolicy = "remove"
+	NoCandidatePark   NoCandidatePolicy = "park"
+)
+
+func (p NoCandidatePolicy) Valid() bool {
+	return p == NoCandidateFail || p == NoCandidateRemove || p == NoCandidatePark
+}
+
+// ReassignOutcome tells what happened to the reviewer slot on reassignment.
+type ReassignOutcome string
+
+const (
+	// OutcomeReplaced means another user took the slot over.
+	OutcomeReplaced ReassignOutcome = "REPLACED"
+	// OutcomeRemoved means the slot was dropped and the PR has fewer reviewers.
+	OutcomeRemoved ReassignOutcome = "REMOVED"
+	// OutcomeParked means the slot was left unfilled for backfill to fill later.
+	OutcomeParked ReassignOutcome = "PARKED"
+)
+
+// ConflictPolicy decides what happens when a team payload contains a user
+// that already belongs to another team.
+type ConflictPolicy string
+
+const (
+	ConflictPolicyFail ConflictPolicy = "fail"
+	ConflictPolicyMove ConflictPolicy = "move"
+	ConflictPolicySkip ConflictPolicy = "skip"
+)
+
+func (p ConflictPolicy) Valid() bool {
+	return p == ConflictPolicyFail || p == ConflictPolicyMove || p == ConflictPolicySkip
+}
+
+// MemberConflictReason tells why a member of a team payload could not be added.
+type MemberConflictReason string
+
+const (
+	// ConflictOtherTeam means the user already belongs to another team.
+	ConflictOtherTeam MemberConflictReason = "OTHER_TEAM"
+	// ConflictDuplicate means the user_id occurs more than once in the payload.
+	ConflictDuplicate MemberConflictReason = "DUPLICATE"
+)
+
+// MemberConflict is a member of a team payload that could not be added as sent.
+// TeamName is the user's current team and is empty for duplicates.
+type MemberConflict struct {
+	UserID   string
+	TeamName string
+	Reason   MemberConflictReason
+}
+
+// ReviewPolicy decides what happens to the open reviews of a user who leaves a team.
+type ReviewPolicy string
+
+const (
+	ReviewPolicyKeep     ReviewPolicy = "keep"
+	ReviewPolicyReassign ReviewPolicy = "reassign"
+	ReviewPolicyFail     ReviewPolicy = "fail"
+)
+
+// Escalation records that an overdue review was handed to a team lead, who was
+// added to the pull request as an extra reviewer.
+type Escalation struct {
+	PullRequestID     string
+	OverdueReviewerID string
+	LeadID            string
+}
+
+// OpenReview is a single reviewer slot on an OPEN pull request. AuthorID and
+// Reviewers describe the pull request, ReviewerHours the slot's reviewer.
+type OpenReview struct {
+	PullRequestID string
+	TeamName      string
+	AuthorID      string
+	Reviewers     []string
+	ReviewerID    string
+	ReviewerHours WorkingHours
+	AssignedAt    time.Time
+}
+
+// Backfill lists the reviewers added to an OPEN pull request that had fewer
+// reviewers than its team's reviewer count.
+type Backfill struct {
+	PullRequestID string
+	TeamName      string
+	ReviewerIDs   []string
+}
+
+// Reassignment describes what happened to a single reviewer slot of a user who
+// left it. NewReviewerID is set only for OutcomeReplaced. TeamName is the team
+// that owns the pull request.
+type Reassignment struct {
+	PullRequestID string
+	TeamName      string
+	OldReviewerID string
+	NewReviewerID string
+	Outcome       ReassignOutcome
+}
+
+// Absence is a scheduled out-of-office window. While it is in effect the user
+// is not picked as a reviewer, whatever their is_active flag says.
+type Absence struct {
+	ID       int64
+	UserID   string
+	StartsAt time.Time
+	EndsAt   time.Time
+	Reason   string
+
+	// ReassignOnStart hands the user's open reviews over to teammates once the
+	// window begins. ReassignedAt is set after that has happened.
+	ReassignOnStart bool
+	ReassignedAt    *time.Time
+}
+
+// AbsenceUpdate holds a partial absence change; nil fields are left untouched.
+type AbsenceUpdate struct {
+	StartsAt        *time.Time
+	EndsAt          *time.Time
+	Reason          *string
+	ReassignOnStart *bool
 }
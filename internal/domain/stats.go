@@ -0,0 +1,90 @@
+package domain
+
+import "time"
+
+// StatsWindow limits statistics to events in [From, To). Nil bounds are open.
+type StatsWindow struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// WholeDays widens the window to whole UTC days, the granularity of the stats rollups.
+func (w StatsWindow) WholeDays() StatsWindow {
+	var result StatsWindow
+	if w.From != nil {
+		from := StartOfDay(*w.From)
+		result.From = &from
+	}
+	if w.To != nil {
+		to := StartOfDay(*w.To)
+		if to.Before(*w.To) {
+			to = to.AddDate(0, 0, 1)
+		}
+		result.To = &to
+	}
+	return result
+}
+
+// StartOfDay returns the start of the UTC day containing t.
+func StartOfDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// ReviewCounts are the review workload figures of a user or a team. OpenLoad is
+// the current number of reviews on OPEN pull requests and ignores the window.
+type ReviewCounts struct {
+	Assignments    int
+	ReassignedAway int
+	ReassignedIn   int
+	MergedReviewed int
+	OpenLoad       int
+}
+
+type UserReviewStats struct {
+	UserID   string
+	Username string
+	ReviewCounts
+}
+
+type TeamReviewStats struct {
+	TeamName string
+	ReviewCounts
+}
+
+// DurationPercentiles summarises Count durations. The percentiles are zero
+// when Count is zero.
+type DurationPercentiles struct {
+	Count int
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+}
+
+// WeeklyThroughput is the number of pull requests merged in the week starting
+// on WeekStart (Monday, UTC).
+type WeeklyThroughput struct {
+	WeekStart time.Time
+	Merged    int
+}
+
+// TeamDeliveryStats describes how quickly the team's pull requests move. Time to
+// merge covers pull requests merged in the window, time to first decision those
+// first decided in it. ReassignmentRate is the share of reviewer assignments in
+// the window that were reassignments.
+type TeamDeliveryStats struct {
+	TeamName            string
+	TimeToMerge         DurationPercentiles
+	TimeToFirstDecision DurationPercentiles
+	Assignments         int
+	Reassignments       int
+	ReassignmentRate    float64
+	Throughput          []WeeklyThroughput
+}
+
+// ReviewerStats is the same workload broken down per reviewer and per team that
+// owns the pull requests.
+type ReviewerStats struct {
+	Users []UserReviewStats
+	Teams []TeamReviewStats
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"net/netip"
+	"time"
+)
+
+// WebhookSubscription is an HTTP endpoint that receives the listed events.
+// Secret signs the deliveries.
+type WebhookSubscription struct {
+	ID        int64
+	URL       string
+	Secret    string
+	Events    []EventType
+	CreatedAt time.Time
+}
+
+type DeliveryStatus string
+
+const (
+	// DeliveryPending deliveries are due at NextAttemptAt.
+	DeliveryPending   DeliveryStatus = "PENDING"
+	DeliverySucceeded DeliveryStatus = "SUCCEEDED"
+	// DeliveryFailed deliveries ran out of attempts; they can be redelivered.
+	DeliveryFailed DeliveryStatus = "FAILED"
+)
+
+func (s DeliveryStatus) Valid() bool {
+	return s == DeliveryPending || s == DeliverySucceeded || s == DeliveryFailed
+}
+
+// WebhookDelivery is an event sent, or to be sent, to one subscription.
+// RedeliveryOf points at the delivery it repeats.
+type WebhookDelivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        string
+	Event          EventType
+	Payload        []byte
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  *time.Time
+	LastAttemptAt  *time.Time
+	ResponseStatus *int
+	LastError      *string
+	RedeliveryOf   *int64
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// DueDelivery is a claimed delivery together with where to send it.
+type DueDelivery struct {
+	Delivery WebhookDelivery
+	URL      string
+	Secret   string
+}
+
+// DeliveryAttempt is the outcome of sending a delivery once. A failed attempt
+// with no NextAttemptAt is the last one.
+type DeliveryAttempt struct {
+	At             time.Time
+	Succeeded      bool
+	ResponseStatus *int
+	Error          string
+	NextAttemptAt  *time.Time
+}
+
+type DeliveryFilter struct {
+	SubscriptionID *int64
+	Status         *DeliveryStatus
+}
+
+// nonPublicPrefixes are ranges that net/netip does not classify but that are
+// not reachable on the internet: "this network", carrier-grade NAT (where some
+// clouds serve instance metadata) and benchmarking.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+}
+
+// PublicAddress reports whether webhooks may be sent to ip: a global unicast
+// address outside private, loopback, link-local (including the 169.254.169.254
+// metadata endpoint) and the other non-public ranges.
+func PublicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,170 @@
+package domain
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+const (
+	DefaultTimezone  = "UTC"
+	DefaultWorkStart = 9 * 60
+	DefaultWorkEnd   = 18 * 60
+)
+
+// WorkingHours is a user's daily working window, Monday to Friday, in their own timezone.
+// Start and End are minutes since local midnight.
+type WorkingHours struct {
+	Timezone string
+	Start    int
+	End      int
+}
+
+// ParseClock converts a "HH:MM" time of day into minutes since midnight.
+// "24:00" is accepted as the end of the day.
+func ParseClock(s string) (int, error) {
+	var h, m int
+	if _, err := fmt.Sscanf(s, "%d:%d", &h, &m); err != nil || len(s) != 5 {
+		return 0, fmt.Errorf("%w: time of day must look like HH:MM, got %q", ErrInvalidInput, s)
+	}
+	minutes := h*60 + m
+	if h < 0 || m < 0 || m >= 60 || minutes > 24*60 {
+		return 0, fmt.Errorf("%w: time of day out of range: %q", ErrInvalidInput, s)
+	}
+	return minutes, nil
+}
+
+func FormatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func ValidateTimezone(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w: timezone must not be empty", ErrInvalidInput)
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("%w: unknown timezone %q", ErrInvalidInput, name)
+	}
+	return nil
+}
+
+func (wh WorkingHours) location() *time.Location {
+	loc, err := time.LoadLocation(wh.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// window returns the working window on the local day that is offset days after t's day.
+func (wh WorkingHours) window(t time.Time, offset int) (time.Time, time.Time, bool) {
+	y, m, d := t.Date()
+	day := time.Date(y, m, d+offset, 0, 0, 0, 0, t.Location())
+	if wd := day.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return time.Time{}, time.Time{}, false
+	}
+	start := time.Date(y, m, d+offset, 0, wh.Start, 0, 0, t.Location())
+	end := time.Date(y, m, d+offset, 0, wh.End, 0, 0, t.Location())
+	return start, end, true
+}
+
+// NextAvailable returns t itself if it falls within working hours, otherwise the
+// start of the next working window.
+func (wh WorkingHours) NextAvailable(t time.Time) time.Time {
+	local := t.In(wh.location())
+	for offset := 0; offset < 8; offset++ {
+		start, end, ok := wh.window(local, offset)
+		if !ok || !local.Before(end) {
+			continue
+		}
+		if local.Before(start) {
+			return start
+		}
+		return t
+	}
+	return t
+}
+
+// BusinessTime returns how much of the interval [from, to) lies within working hours.
+func (wh WorkingHours) BusinessTime(from, to time.Time) time.Duration {
+	local := from.In(wh.location())
+	y, m, d := local.Date()
+
+	var total time.Duration
+	for offset := 0; from.Before(to); offset++ {
+		if time.Date(y, m, d+offset, 0, 0, 0, 0, local.Location()).After(to) {
+			break
+		}
+		start, end, ok := wh.window(local, offset)
+		if !ok {
+			continue
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if start.Before(end) {
+			total += end.Sub(start)
+		}
+	}
+	return total
+}
+
+// AddBusinessTime returns the moment at which d of working time has passed since from.
+func (wh WorkingHours) AddBusinessTime(from time.Time, d time.Duration) time.Time {
+	if d <= 0 {
+		return from
+	}
+
+	local := from.In(wh.location())
+	for offset := 0; ; offset++ {
+		start, end, ok := wh.window(local, offset)
+		if !ok {
+			continue
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if !start.Before(end) {
+			continue
+		}
+		avail := end.Sub(start)
+		if d <= avail {
+			return start.Add(d)
+		}
+		d -= avail
+	}
+}
+
+// OrderCandidates shuffles candidates in place. With working-hours selection the
+// ones who are at work at the given moment come first, followed by those whose
+// working day starts soonest; ties keep the random order.
+func OrderCandidates(candidates []User, mode ReviewerSelection, at time.Time) {
+	OrderCandidatesByLoad(candidates, mode, at, nil)
+}
+
+// OrderCandidatesByLoad orders candidates like OrderCandidates, except that
+// candidates the selection ranks equally are ordered by their number of open
+// reviews, fewest first. Users missing from load have none.
+func OrderCandidatesByLoad(candidates []User, mode ReviewerSelection, at time.Time, load map[string]int) {
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	waits := make(map[string]time.Duration, len(candidates))
+	if mode == SelectionWorkingHours {
+		for _, c := range candidates {
+			waits[c.ID] = c.WorkingHours.NextAvailable(at).Sub(at)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i].ID, candidates[j].ID
+		if waits[a] != waits[b] {
+			return waits[a] < waits[b]
+		}
+		return load[a] < load[b]
+	})
+}
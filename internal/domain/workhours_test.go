@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderCandidatesByLoad(t *testing.T) {
+	// A Wednesday at noon UTC.
+	at := time.Date(2025, 11, 19, 12, 0, 0, 0, time.UTC)
+	office := WorkingHours{Timezone: "UTC", Start: 9 * 60, End: 18 * 60}
+	night := WorkingHours{Timezone: "UTC", Start: 20 * 60, End: 23 * 60}
+
+	tests := []struct {
+		name       string
+		candidates []User
+		mode       ReviewerSelection
+		load       map[string]int
+		want       string
+	}{
+		{
+			name:       "fewest open reviews first",
+			candidates: []User{{ID: "busy"}, {ID: "idle"}, {ID: "some"}},
+			mode:       SelectionRandom,
+			load:       map[string]int{"busy": 5, "some": 2},
+			want:       "idle",
+		},
+		{
+			name: "working hours come before load",
+			candidates: []User{
+				{ID: "off", WorkingHours: night},
+				{ID: "busy", WorkingHours: office},
+				{ID: "idle", WorkingHours: night},
+			},
+			mode: SelectionWorkingHours,
+			load: map[string]int{"busy": 3},
+			want: "busy",
+		},
+		{
+			name: "load breaks working hours ties",
+			candidates: []User{
+				{ID: "busy", WorkingHours: office},
+				{ID: "idle", WorkingHours: office},
+				{ID: "off", WorkingHours: night},
+			},
+			mode: SelectionWorkingHours,
+			load: map[string]int{"busy": 1},
+			want: "idle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The order starts with a shuffle; the first pick must not depend on it.
+			for range 20 {
+				candidates := append([]User(nil), tt.candidates...)
+				OrderCandidatesByLoad(candidates, tt.mode, at, tt.load)
+				if candidates[0].ID != tt.want {
+					t.Fatalf("first candidate = %s, want %s", candidates[0].ID, tt.want)
+				}
+			}
+		})
+	}
+}
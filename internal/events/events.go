@@ -0,0 +1,129 @@
+// Package events builds the domain events that repositories record in the
+// outbox. Payloads are built while the change is being made, so they describe
+// the state the change was committed with.
+package events
+
+import (
+	"avito-test-task/internal/domain"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// envelope is the JSON body of every event.
+type envelope struct {
+	ID         string           `json:"id"`
+	Type       domain.EventType `json:"type"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Data       any              `json:"data"`
+}
+
+type pullRequestData struct {
+	PullRequestID     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	AuthorID          string     `json:"author_id"`
+	TeamName          string     `json:"team_name"`
+	Status            string     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+}
+
+type reviewerAssignedData struct {
+	PullRequestID string `json:"pull_request_id"`
+	TeamName      string `json:"team_name"`
+	ReviewerID    string `json:"reviewer_id"`
+	Reason        string `json:"reason"`
+}
+
+type reviewerReassignedData struct {
+	PullRequestID string                 `json:"pull_request_id"`
+	OldReviewerID string                 `json:"old_reviewer_id"`
+	NewReviewerID *string                `json:"new_reviewer_id"`
+	Outcome       domain.ReassignOutcome `json:"outcome"`
+	Reason        string                 `json:"reason"`
+}
+
+type userDeactivatedData struct {
+	UserID string `json:"user_id"`
+}
+
+func newEvent(eventType domain.EventType, key string, data any) domain.Event {
+	id := uuid.NewString()
+	// The envelope only holds plain values, so marshalling cannot fail.
+	payload, _ := json.Marshal(envelope{ID: id, Type: eventType, OccurredAt: time.Now().UTC(), Data: data})
+	return domain.Event{ID: id, Type: eventType, Key: key, Payload: payload}
+}
+
+func PRCreated(pr domain.PullRequest) domain.Event {
+	return pullRequestEvent(domain.EventPRCreated, pr)
+}
+
+func PRMerged(pr domain.PullRequest) domain.Event {
+	return pullRequestEvent(domain.EventPRMerged, pr)
+}
+
+func pullRequestEvent(eventType domain.EventType, pr domain.PullRequest) domain.Event {
+	reviewers := pr.Reviewers
+	if reviewers == nil {
+		reviewers = []string{}
+	}
+	var createdAt *time.Time
+	if !pr.CreatedAt.IsZero() {
+		createdAt = &pr.CreatedAt
+	}
+	return newEvent(eventType, pr.ID, pullRequestData{
+		PullRequestID:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorID:          pr.AuthorID,
+		TeamName:          pr.TeamName,
+		Status:            string(pr.Status),
+		AssignedReviewers: reviewers,
+		CreatedAt:         createdAt,
+		MergedAt:          pr.MergedAt,
+	})
+}
+
+// ReviewersAssigned returns a reviewer.assigned event per reviewer.
+func ReviewersAssigned(prID, teamName, reason string, reviewerIDs ...string) []domain.Event {
+	events := make([]domain.Event, len(reviewerIDs))
+	for i, id := range reviewerIDs {
+		events[i] = newEvent(domain.EventReviewerAssigned, prID, reviewerAssignedData{
+			PullRequestID: prID, TeamName: teamName, ReviewerID: id, Reason: reason,
+		})
+	}
+	return events
+}
+
+// ReviewerReassigned reports a slot handed over to another reviewer, or given
+// up without one, as the outcome tells.
+func ReviewerReassigned(reason string, ra domain.Reassignment) domain.Event {
+	data := reviewerReassignedData{PullRequestID: ra.PullRequestID, OldReviewerID: ra.OldReviewerID, Outcome: ra.Outcome, Reason: reason}
+	if ra.NewReviewerID != "" {
+		data.NewReviewerID = &ra.NewReviewerID
+	}
+	return newEvent(domain.EventReviewerReassigned, ra.PullRequestID, data)
+}
+
+// ReviewsMoved returns a reviewer.reassigned event per slot that changed:
+// handed over, removed or parked. Slots without an outcome were left as they
+// were and get no event.
+func ReviewsMoved(reason string, reassigned []domain.Reassignment) []domain.Event {
+	var events []domain.Event
+	for _, ra := range reassigned {
+		if ra.Outcome != "" {
+			events = append(events, ReviewerReassigned(reason, ra))
+		}
+	}
+	return events
+}
+
+// UsersDeactivated returns a user.deactivated event per user.
+func UsersDeactivated(userIDs ...string) []domain.Event {
+	events := make([]domain.Event, len(userIDs))
+	for i, id := range userIDs {
+		events[i] = newEvent(domain.EventUserDeactivated, id, userDeactivatedData{UserID: id})
+	}
+	return events
+}
@@ -0,0 +1,59 @@
+package events
+
+import (
+	"avito-test-task/internal/domain"
+	"encoding/json"
+	"testing"
+)
+
+func TestReviewsMoved(t *testing.T) {
+	reassigned := []domain.Reassignment{
+		{PullRequestID: "pr-1", OldReviewerID: "u1", NewReviewerID: "u2", Outcome: domain.OutcomeReplaced},
+		{PullRequestID: "pr-2", OldReviewerID: "u1", Outcome: domain.OutcomeRemoved},
+		{PullRequestID: "pr-3", OldReviewerID: "u1", Outcome: domain.OutcomeParked},
+		{PullRequestID: "pr-4", OldReviewerID: "u1"},
+	}
+
+	type data struct {
+		PullRequestID string  `json:"pull_request_id"`
+		NewReviewerID *string `json:"new_reviewer_id"`
+		Outcome       string  `json:"outcome"`
+		Reason        string  `json:"reason"`
+	}
+	want := []struct {
+		prID        string
+		newReviewer string
+		outcome     domain.ReassignOutcome
+	}{
+		{prID: "pr-1", newReviewer: "u2", outcome: domain.OutcomeReplaced},
+		{prID: "pr-2", outcome: domain.OutcomeRemoved},
+		{prID: "pr-3", outcome: domain.OutcomeParked},
+	}
+
+	got := ReviewsMoved(domain.ReassignAbsence, reassigned)
+	if len(got) != len(want) {
+		t.Fatalf("got %d events, want %d", len(got), len(want))
+	}
+	for i, e := range got {
+		var env struct {
+			Type domain.EventType `json:"type"`
+			Data data             `json:"data"`
+		}
+		if err := json.Unmarshal(e.Payload, &env); err != nil {
+			t.Fatal(err)
+		}
+		w := want[i]
+		if e.Key != w.prID || env.Type != domain.EventReviewerReassigned || env.Data.PullRequestID != w.prID {
+			t.Errorf("event %d = %s %s for %s, want reviewer.reassigned for %s", i, e.Key, env.Type, env.Data.PullRequestID, w.prID)
+		}
+		if env.Data.Outcome != string(w.outcome) || env.Data.Reason != domain.ReassignAbsence {
+			t.Errorf("event %d outcome, reason = %s, %s; want %s, %s", i, env.Data.Outcome, env.Data.Reason, w.outcome, domain.ReassignAbsence)
+		}
+		switch {
+		case w.newReviewer == "" && env.Data.NewReviewerID != nil:
+			t.Errorf("event %d new_reviewer_id = %s, want null", i, *env.Data.NewReviewerID)
+		case w.newReviewer != "" && (env.Data.NewReviewerID == nil || *env.Data.NewReviewerID != w.newReviewer):
+			t.Errorf("event %d new_reviewer_id = %v, want %s", i, env.Data.NewReviewerID, w.newReviewer)
+		}
+	}
+}
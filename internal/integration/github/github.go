@@ -0,0 +1,113 @@
+// Package github turns GitHub webhook deliveries into domain events. It does no
+// I/O, so recorded deliveries in testdata can be replayed against it directly.
+package github
+
+import (
+	"avito-test-task/internal/domain"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// EventHeader names the event type of a delivery.
+	EventHeader = "X-GitHub-Event"
+	// SignatureHeader carries the HMAC-SHA256 of the body keyed with the webhook secret.
+	SignatureHeader = "X-Hub-Signature-256"
+
+	pullRequestEvent = "pull_request"
+	signaturePrefix  = "sha256="
+)
+
+// VerifySignature reports whether the X-Hub-Signature-256 value matches the
+// body. An empty secret matches nothing.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	if secret == "" || !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// Sign returns the X-Hub-Signature-256 value of the body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+type pullRequestPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+		RequestedTeams []struct {
+			Slug string `json:"slug"`
+		} `json:"requested_teams"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// ParseEvent parses a delivery of the given event type. It returns false for
+// events and actions the service does not track, such as ping or edited.
+// Pull requests are identified as "owner/repo#number"; requested reviewer teams
+// are reported by slug.
+func ParseEvent(eventType string, body []byte) (domain.ExternalPREvent, bool, error) {
+	if eventType != pullRequestEvent {
+		return domain.ExternalPREvent{}, false, nil
+	}
+
+	var p pullRequestPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return domain.ExternalPREvent{}, false, fmt.Errorf("%w: malformed pull_request payload", domain.ErrInvalidInput)
+	}
+
+	event := domain.ExternalPREvent{
+		Provider:      domain.ProviderGitHub,
+		PullRequestID: PullRequestID(p.Repository.FullName, p.PullRequest.Number),
+		Title:         p.PullRequest.Title,
+		AuthorLogin:   p.PullRequest.User.Login,
+	}
+	for _, t := range p.PullRequest.RequestedTeams {
+		event.Teams = append(event.Teams, t.Slug)
+	}
+
+	switch p.Action {
+	case "opened":
+		event.Action = domain.ExternalPROpened
+	case "reopened":
+		event.Action = domain.ExternalPRReopened
+	case "closed":
+		event.Action = domain.ExternalPRClosed
+		if p.PullRequest.Merged {
+			event.Action = domain.ExternalPRMerged
+		}
+	default:
+		return domain.ExternalPREvent{}, false, nil
+	}
+
+	if p.Repository.FullName == "" || p.PullRequest.Number == 0 || p.PullRequest.User.Login == "" {
+		return domain.ExternalPREvent{}, false, fmt.Errorf("%w: pull_request payload lacks repository, number or author", domain.ErrInvalidInput)
+	}
+	return event, true, nil
+}
+
+// PullRequestID is the id a GitHub pull request gets in the service.
+func PullRequestID(repoFullName string, number int) string {
+	return fmt.Sprintf("%s#%d", repoFullName, number)
+}
@@ -0,0 +1,177 @@
+package github
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/integration/integrationtest"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseEvent(t *testing.T) {
+	tests := []struct {
+		fixture string
+		action  domain.ExternalPRAction
+	}{
+		{fixture: "pull_request.opened.json", action: domain.ExternalPROpened},
+		{fixture: "pull_request.reopened.json", action: domain.ExternalPRReopened},
+		{fixture: "pull_request.closed.json", action: domain.ExternalPRClosed},
+		{fixture: "pull_request.merged.json", action: domain.ExternalPRMerged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			got, ok, err := ParseEvent(pullRequestEvent, integrationtest.ReadFixture(t, tt.fixture))
+			if err != nil || !ok {
+				t.Fatalf("ParseEvent = %v, %v; want a tracked event", ok, err)
+			}
+			want := domain.ExternalPREvent{
+				Provider:      domain.ProviderGitHub,
+				Action:        tt.action,
+				PullRequestID: "acme/payments#42",
+				Title:         "Retry card authorisation on gateway timeout",
+				AuthorLogin:   "alice-dev",
+				Teams:         []string{"payments-backend"},
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("event = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+// A closed delivery is a merge only if the pull request says it was merged.
+func TestParseEventMergedFlag(t *testing.T) {
+	tests := []struct {
+		fixture string
+		merged  bool
+		want    domain.ExternalPRAction
+	}{
+		{fixture: "pull_request.closed.json", merged: true, want: domain.ExternalPRMerged},
+		{fixture: "pull_request.merged.json", merged: false, want: domain.ExternalPRClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			body := integrationtest.WithField(t, integrationtest.ReadFixture(t, tt.fixture), "pull_request.merged", tt.merged)
+			got, ok, err := ParseEvent(pullRequestEvent, body)
+			if err != nil || !ok {
+				t.Fatalf("ParseEvent = %v, %v; want a tracked event", ok, err)
+			}
+			if got.Action != tt.want {
+				t.Errorf("action = %s, want %s", got.Action, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEventRequestedTeams(t *testing.T) {
+	opened := integrationtest.ReadFixture(t, "pull_request.opened.json")
+
+	tests := []struct {
+		name  string
+		teams []any
+		want  []string
+	}{
+		{
+			name:  "kept in request order",
+			teams: []any{map[string]any{"slug": "platform"}, map[string]any{"slug": "payments-backend"}},
+			want:  []string{"platform", "payments-backend"},
+		},
+		{name: "none requested", teams: []any{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := integrationtest.WithField(t, opened, "pull_request.requested_teams", tt.teams)
+			got, ok, err := ParseEvent(pullRequestEvent, body)
+			if err != nil || !ok {
+				t.Fatalf("ParseEvent = %v, %v; want a tracked event", ok, err)
+			}
+			if !reflect.DeepEqual(got.Teams, tt.want) {
+				t.Errorf("teams = %q, want %q", got.Teams, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEventIgnored(t *testing.T) {
+	opened := integrationtest.ReadFixture(t, "pull_request.opened.json")
+
+	tests := []struct {
+		name      string
+		eventType string
+		body      []byte
+	}{
+		{name: "ping", eventType: "ping", body: integrationtest.ReadFixture(t, "ping.json")},
+		{name: "edited", eventType: pullRequestEvent, body: integrationtest.ReadFixture(t, "pull_request.edited.json")},
+		{name: "synchronize", eventType: pullRequestEvent, body: integrationtest.WithField(t, opened, "action", "synchronize")},
+		{name: "review event with a pull request", eventType: "pull_request_review", body: opened},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := ParseEvent(tt.eventType, tt.body)
+			if err != nil || ok {
+				t.Errorf("ParseEvent = %+v, %v, %v; want ignored", got, ok, err)
+			}
+		})
+	}
+}
+
+func TestParseEventInvalid(t *testing.T) {
+	opened := integrationtest.ReadFixture(t, "pull_request.opened.json")
+
+	tests := []struct {
+		name string
+		body []byte
+	}{
+		{name: "malformed json", body: []byte(`{"action":`)},
+		{name: "missing repository", body: integrationtest.WithField(t, opened, "repository", nil)},
+		{name: "missing number", body: integrationtest.WithField(t, opened, "pull_request.number", nil)},
+		{name: "missing author", body: integrationtest.WithField(t, opened, "pull_request.user", nil)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok, err := ParseEvent(pullRequestEvent, tt.body)
+			if !errors.Is(err, domain.ErrInvalidInput) {
+				t.Errorf("err = %v, want %v", err, domain.ErrInvalidInput)
+			}
+			if ok {
+				t.Error("ok = true, want false")
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	const secret = "s3cret"
+	body := integrationtest.ReadFixture(t, "pull_request.opened.json")
+	tampered := append([]byte(nil), body...)
+	tampered[len(tampered)-2] = ' '
+
+	tests := []struct {
+		name      string
+		secret    string
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{name: "valid", secret: secret, body: body, signature: Sign(secret, body), want: true},
+		{name: "tampered body", secret: secret, body: tampered, signature: Sign(secret, body)},
+		{name: "other secret", secret: secret, body: body, signature: Sign("other", body)},
+		{name: "missing signature", secret: secret, body: body, signature: ""},
+		{name: "missing prefix", secret: secret, body: body, signature: Sign(secret, body)[len(signaturePrefix):]},
+		{name: "malformed hex", secret: secret, body: body, signature: signaturePrefix + "zz"},
+		{name: "no secret configured", secret: "", body: body, signature: Sign("", body)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(tt.secret, tt.body, tt.signature); got != tt.want {
+				t.Errorf("VerifySignature = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
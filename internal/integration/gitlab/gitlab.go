@@ -0,0 +1,124 @@
+// Package gitlab turns GitLab webhook deliveries into domain events. It does no
+// I/O, so recorded deliveries in testdata can be replayed against it directly.
+package gitlab
+
+import (
+	"avito-test-task/internal/domain"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// EventHeader names the event type of a delivery.
+	EventHeader = "X-Gitlab-Event"
+	// TokenHeader carries the secret token configured on the webhook.
+	TokenHeader = "X-Gitlab-Token"
+
+	// MergeRequestEvent is the event type of merge request deliveries.
+	MergeRequestEvent = "Merge Request Hook"
+)
+
+// VerifyToken reports whether the X-Gitlab-Token value is the configured token.
+// An empty token matches nothing.
+func VerifyToken(token, got string) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(got)) == 1
+}
+
+type mergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		ID       int64  `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID      int    `json:"iid"`
+		Title    string `json:"title"`
+		AuthorID int64  `json:"author_id"`
+		State    string `json:"state"`
+		Action   string `json:"action"`
+	} `json:"object_attributes"`
+}
+
+// ParseEvent parses a delivery of the given event type. It returns false for
+// events and actions the service does not track, such as approvals. Merge
+// requests are identified as "group/project!iid"; the groups the project is
+// nested in are reported as teams, innermost first.
+//
+// GitLab names the user who triggered the event rather than the author, so the
+// author is only known when they are the same user, as on open.
+func ParseEvent(eventType string, body []byte) (domain.ExternalPREvent, bool, error) {
+	if eventType != MergeRequestEvent {
+		return domain.ExternalPREvent{}, false, nil
+	}
+
+	var p mergeRequestPayload
+	if err := json.Unmarshal(body, &p); err != nil || p.ObjectKind != "merge_request" {
+		return domain.ExternalPREvent{}, false, fmt.Errorf("%w: malformed merge request payload", domain.ErrInvalidInput)
+	}
+
+	attrs := p.ObjectAttributes
+	event := domain.ExternalPREvent{
+		Provider:      domain.ProviderGitLab,
+		PullRequestID: MergeRequestID(p.Project.PathWithNamespace, attrs.IID),
+		Title:         attrs.Title,
+		Teams:         groups(p.Project.PathWithNamespace),
+	}
+	if p.User.ID == attrs.AuthorID {
+		event.AuthorLogin = p.User.Username
+	}
+
+	switch attrs.Action {
+	case "open":
+		event.Action = domain.ExternalPROpened
+	case "reopen":
+		event.Action = domain.ExternalPRReopened
+	case "close":
+		event.Action = domain.ExternalPRClosed
+	case "merge":
+		event.Action = domain.ExternalPRMerged
+	case "update":
+		// An update may be the first delivery about a merge request, so it is
+		// applied according to the state the merge request is in.
+		switch attrs.State {
+		case "opened":
+			event.Action = domain.ExternalPRUpdated
+		case "closed":
+			event.Action = domain.ExternalPRClosed
+		case "merged":
+			event.Action = domain.ExternalPRMerged
+		default:
+			return domain.ExternalPREvent{}, false, nil
+		}
+	default:
+		return domain.ExternalPREvent{}, false, nil
+	}
+
+	if p.Project.PathWithNamespace == "" || attrs.IID == 0 {
+		return domain.ExternalPREvent{}, false, fmt.Errorf("%w: merge request payload lacks project or iid", domain.ErrInvalidInput)
+	}
+	return event, true, nil
+}
+
+// MergeRequestID is the id a GitLab merge request gets in the service.
+func MergeRequestID(projectPath string, iid int) string {
+	return fmt.Sprintf("%s!%d", projectPath, iid)
+}
+
+// groups returns the full paths of the groups a project is nested in,
+// innermost first: "a/b/c" yields "a/b" and "a".
+func groups(projectPath string) []string {
+	var result []string
+	for path := projectPath; ; {
+		i := strings.LastIndex(path, "/")
+		if i < 0 {
+			return result
+		}
+		path = path[:i]
+		result = append(result, path)
+	}
+}
@@ -0,0 +1,196 @@
+package gitlab
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/integration/integrationtest"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseEvent(t *testing.T) {
+	const title = "Round FX conversions half-even"
+
+	// GitLab names whoever triggered the event; only asmith is the author.
+	tests := []struct {
+		fixture string
+		action  domain.ExternalPRAction
+		title   string
+		author  string
+	}{
+		{fixture: "merge_request.open.json", action: domain.ExternalPROpened, title: title, author: "asmith"},
+		{fixture: "merge_request.reopen.json", action: domain.ExternalPRReopened, title: title, author: "asmith"},
+		{fixture: "merge_request.update.json", action: domain.ExternalPRUpdated, title: title + " (banker's rounding)", author: "asmith"},
+		{fixture: "merge_request.close.json", action: domain.ExternalPRClosed, title: title},
+		{fixture: "merge_request.merge.json", action: domain.ExternalPRMerged, title: title},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			got, ok, err := ParseEvent(MergeRequestEvent, integrationtest.ReadFixture(t, tt.fixture))
+			if err != nil || !ok {
+				t.Fatalf("ParseEvent = %v, %v; want a tracked event", ok, err)
+			}
+			want := domain.ExternalPREvent{
+				Provider:      domain.ProviderGitLab,
+				Action:        tt.action,
+				PullRequestID: "finance/ledger/ledger-api!17",
+				Title:         tt.title,
+				AuthorLogin:   tt.author,
+				Teams:         []string{"finance/ledger", "finance"},
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("event = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+// An update can be the first delivery about a merge request, so it is applied
+// according to the state the merge request is in.
+func TestParseEventUpdateFollowsState(t *testing.T) {
+	update := integrationtest.ReadFixture(t, "merge_request.update.json")
+
+	tests := []struct {
+		state  string
+		want   domain.ExternalPRAction
+		wantOK bool
+	}{
+		{state: "opened", want: domain.ExternalPRUpdated, wantOK: true},
+		{state: "closed", want: domain.ExternalPRClosed, wantOK: true},
+		{state: "merged", want: domain.ExternalPRMerged, wantOK: true},
+		{state: "locked"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			body := integrationtest.WithField(t, update, "object_attributes.state", tt.state)
+			got, ok, err := ParseEvent(MergeRequestEvent, body)
+			if err != nil {
+				t.Fatalf("ParseEvent: %v", err)
+			}
+			if ok != tt.wantOK || got.Action != tt.want {
+				t.Errorf("ParseEvent = %s, %v; want %s, %v", got.Action, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseEventAuthor(t *testing.T) {
+	// bjones closed asmith's merge request.
+	closed := integrationtest.ReadFixture(t, "merge_request.close.json")
+
+	tests := []struct {
+		name     string
+		authorID float64
+		want     string
+	}{
+		{name: "someone else triggered it", authorID: 117},
+		{name: "the author triggered it", authorID: 204, want: "bjones"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := integrationtest.WithField(t, closed, "object_attributes.author_id", tt.authorID)
+			got, _, err := ParseEvent(MergeRequestEvent, body)
+			if err != nil {
+				t.Fatalf("ParseEvent: %v", err)
+			}
+			if got.AuthorLogin != tt.want {
+				t.Errorf("author = %q, want %q", got.AuthorLogin, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseEventGroups(t *testing.T) {
+	open := integrationtest.ReadFixture(t, "merge_request.open.json")
+
+	tests := []struct {
+		project string
+		want    []string
+	}{
+		{project: "finance/ledger/fx/ledger-api", want: []string{"finance/ledger/fx", "finance/ledger", "finance"}},
+		{project: "asmith/scratch", want: []string{"asmith"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.project, func(t *testing.T) {
+			body := integrationtest.WithField(t, open, "project.path_with_namespace", tt.project)
+			got, ok, err := ParseEvent(MergeRequestEvent, body)
+			if err != nil || !ok {
+				t.Fatalf("ParseEvent = %v, %v; want a tracked event", ok, err)
+			}
+			if !reflect.DeepEqual(got.Teams, tt.want) {
+				t.Errorf("teams = %q, want %q", got.Teams, tt.want)
+			}
+			if want := tt.project + "!17"; got.PullRequestID != want {
+				t.Errorf("id = %q, want %q", got.PullRequestID, want)
+			}
+		})
+	}
+}
+
+func TestParseEventUnsupported(t *testing.T) {
+	open := integrationtest.ReadFixture(t, "merge_request.open.json")
+
+	ignored := []struct {
+		name      string
+		eventType string
+		body      []byte
+	}{
+		{name: "other event type", eventType: "Note Hook", body: open},
+		{name: "approval", eventType: MergeRequestEvent, body: integrationtest.ReadFixture(t, "merge_request.approved.json")},
+	}
+	for _, tt := range ignored {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok, err := ParseEvent(tt.eventType, tt.body)
+			if err != nil || ok {
+				t.Errorf("ParseEvent = %v, %v; want ignored", ok, err)
+			}
+		})
+	}
+
+	invalid := []struct {
+		name string
+		body []byte
+	}{
+		{name: "unsupported object_kind", body: integrationtest.WithField(t, open, "object_kind", "note")},
+		{name: "malformed json", body: []byte(`{"object_kind":`)},
+		{name: "missing iid", body: integrationtest.WithField(t, open, "object_attributes.iid", nil)},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok, err := ParseEvent(MergeRequestEvent, tt.body)
+			if !errors.Is(err, domain.ErrInvalidInput) {
+				t.Errorf("err = %v, want %v", err, domain.ErrInvalidInput)
+			}
+			if ok {
+				t.Error("ok = true, want false")
+			}
+		})
+	}
+}
+
+func TestVerifyToken(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		got   string
+		want  bool
+	}{
+		{name: "matching token", token: "s3cret", got: "s3cret", want: true},
+		{name: "wrong token", token: "s3cret", got: "guess"},
+		{name: "token prefix", token: "s3cret", got: "s3c"},
+		{name: "missing token", token: "s3cret", got: ""},
+		{name: "no token configured", token: "", got: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyToken(tt.token, tt.got); got != tt.want {
+				t.Errorf("VerifyToken = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Package integrationtest holds helpers shared by the code host parser tests:
+// reading recorded deliveries from testdata and deriving edge cases from them.
+package integrationtest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// ReadFixture returns the recorded delivery testdata/name of the calling
+// test's package.
+func ReadFixture(t testing.TB, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+// WithField returns a copy of the JSON delivery with the field at the dotted
+// path set to value, so that an edge case keeps everything else of a real
+// delivery. A nil value removes the field.
+func WithField(t testing.TB, body []byte, path string, value any) []byte {
+	t.Helper()
+	var doc map[string]any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	keys := strings.Split(path, ".")
+	obj := doc
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := obj[key].(map[string]any)
+		if !ok {
+			t.Fatalf("%s: %q is not an object", path, key)
+		}
+		obj = next
+	}
+	last := keys[len(keys)-1]
+	if value == nil {
+		delete(obj, last)
+	} else {
+		obj[last] = value
+	}
+
+	patched, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return patched
+}
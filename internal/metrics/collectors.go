@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	return &poolCollector{
+		pool:                 pool,
+		acquiredConns:        desc("acquired_connections", "Connections currently in use."),
+		idleConns:            desc("idle_connections", "Idle connections."),
+		totalConns:           desc("total_connections", "All open connections."),
+		maxConns:             desc("max_connections", "Maximum pool size."),
+		acquireCount:         desc("acquires_total", "Successful connection acquires."),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Total time spent acquiring connections."),
+		emptyAcquireCount:    desc("empty_acquires_total", "Acquires that had to wait for a connection."),
+		canceledAcquireCount: desc("canceled_acquires_total", "Acquires cancelled by their context."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	s := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(s.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(s.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(s.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(s.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(s.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, s.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(s.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(s.CanceledAcquireCount()))
+}
+
+// openReviewsTimeout bounds the query run on every scrape.
+const openReviewsTimeout = 5 * time.Second
+
+// openReviewsBuckets are the upper bounds of the per-reviewer load histogram.
+var openReviewsBuckets = []float64{1, 2, 3, 5, 8, 13, 21}
+
+type openReviewsCollector struct {
+	load func(context.Context) (map[string]int, error)
+	desc *prometheus.Desc
+}
+
+func newOpenReviewsCollector(load func(context.Context) (map[string]int, error)) *openReviewsCollector {
+	return &openReviewsCollector{
+		load: load,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "open_reviews_per_reviewer"),
+			"Reviews on OPEN pull requests per reviewer who has any.",
+			nil, nil,
+		),
+	}
+}
+
+func (c *openReviewsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *openReviewsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), openReviewsTimeout)
+	defer cancel()
+
+	load, err := c.load(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.desc, err)
+		return
+	}
+	buckets := make(map[float64]uint64, len(openReviewsBuckets))
+	var sum float64
+	for _, n := range load {
+		sum += float64(n)
+		for _, le := range openReviewsBuckets {
+			if float64(n) <= le {
+				buckets[le]++
+			}
+		}
+	}
+	ch <- prometheus.MustNewConstHistogram(c.desc, uint64(len(load)), sum, buckets)
+}
@@ -0,0 +1,131 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "reviewer_service"
+
+// Metrics holds the Prometheus collectors of the service. It implements
+// service.Recorder for the domain counters.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpDuration  *prometheus.HistogramVec
+	prsCreated    *prometheus.CounterVec
+	prsMerged     *prometheus.CounterVec
+	reassignments *prometheus.CounterVec
+	noCandidate   *prometheus.CounterVec
+	webhooks      *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Duration of HTTP requests by route and status.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+		prsCreated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pull_requests_created_total",
+			Help:      "Pull requests created, by owning team.",
+		}, []string{"team"}),
+		prsMerged: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pull_requests_merged_total",
+			Help:      "Pull requests merged, by owning team.",
+		}, []string{"team"}),
+		reassignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "review_reassignments_total",
+			Help:      "Reviewer slots moved to another reviewer, by reason.",
+		}, []string{"reason"}),
+		noCandidate: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "no_candidate_total",
+			Help:      "Reviewer selections and reassigned slots that found no candidate, by team.",
+		}, []string{"team"}),
+		webhooks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "webhook_delivery_attempts_total",
+			Help:      "Outgoing webhook delivery attempts, by outcome.",
+		}, []string{"outcome"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpDuration, m.prsCreated, m.prsMerged, m.reassignments, m.noCandidate, m.webhooks,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Middleware observes request durations. It must be used inside a chi router so
+// that requests are labelled with the route pattern rather than the raw path.
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		route := "unmatched"
+		if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+			route = rctx.RoutePattern()
+		}
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		m.httpDuration.WithLabelValues(r.Method, route, strconv.Itoa(status)).Observe(time.Since(start).Seconds())
+	})
+}
+
+// RegisterPool exports the connection pool statistics.
+func (m *Metrics) RegisterPool(pool *pgxpool.Pool) {
+	m.registry.MustRegister(newPoolCollector(pool))
+}
+
+// RegisterOpenReviews exports how open reviews are spread over reviewers, read
+// with load on every scrape. Users are not labelled, so the series count stays
+// fixed however many users there are.
+func (m *Metrics) RegisterOpenReviews(load func(context.Context) (map[string]int, error)) {
+	m.registry.MustRegister(newOpenReviewsCollector(load))
+}
+
+func (m *Metrics) PullRequestCreated(teamName string) {
+	m.prsCreated.WithLabelValues(teamName).Inc()
+}
+
+func (m *Metrics) PullRequestMerged(teamName string) {
+	m.prsMerged.WithLabelValues(teamName).Inc()
+}
+
+func (m *Metrics) ReviewsReassigned(reason string, count int) {
+	m.reassignments.WithLabelValues(reason).Add(float64(count))
+}
+
+func (m *Metrics) NoCandidate(teamName string) {
+	m.noCandidate.WithLabelValues(teamName).Inc()
+}
+
+func (m *Metrics) WebhookDelivered(outcome string) {
+	m.webhooks.WithLabelValues(outcome).Inc()
+}
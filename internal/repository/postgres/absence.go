@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AbsenceRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewAbsenceRepo(db *pgxpool.Pool) *AbsenceRepo {
+	return &AbsenceRepo{db: db}
+}
+
+const absenceColumns = "id, user_id, starts_at, ends_at, reason, reassign_on_start, reassigned_at"
+
+func scanAbsence(row pgx.Row) (domain.Absence, error) {
+	var a domain.Absence
+	err := row.Scan(&a.ID, &a.UserID, &a.StartsAt, &a.EndsAt, &a.Reason, &a.ReassignOnStart, &a.ReassignedAt)
+	return a, err
+}
+
+// Create stores a new absence. If it is already in effect and asks for
+// reassignment, the user's open reviews are handed over in the same transaction.
+func (r *AbsenceRepo) Create(ctx context.Context, a domain.Absence) (domain.Absence, []domain.Reassignment, error) {
+	var created domain.Absence
+	var reassigned []domain.Reassignment
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var err error
+		created, err = scanAbsence(tx.QueryRow(ctx, `
+			INSERT INTO user_absences (user_id, starts_at, ends_at, reason, reassign_on_start)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING `+absenceColumns,
+			a.UserID, a.StartsAt, a.EndsAt, a.Reason, a.ReassignOnStart))
+		if err != nil {
+			return mapAbsenceError(err)
+		}
+
+		reassigned, err = reassignIfStarted(ctx, tx, &created)
+		if err != nil {
+			return err
+		}
+		return insertOutbox(ctx, tx, events.ReviewsMoved(domain.ReassignAbsence, reassigned)...)
+	})
+
+	if err != nil {
+		return domain.Absence{}, nil, err
+	}
+	return created, reassigned, nil
+}
+
+func (r *AbsenceRepo) GetByID(ctx context.Context, id int64) (domain.Absence, error) {
+	a, err := scanAbsence(r.db.QueryRow(ctx, "SELECT "+absenceColumns+" FROM user_absences WHERE id = $1", id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.Absence{}, domain.ErrNotFound
+	}
+	return a, err
+}
+
+func (r *AbsenceRepo) ListByUser(ctx context.Context, userID string, includePast bool) ([]domain.Absence, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+absenceColumns+`
+		FROM user_absences
+		WHERE user_id = $1 AND ($2 OR ends_at > NOW())
+		ORDER BY starts_at`, userID, includePast)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var absences []domain.Absence
+	for rows.Next() {
+		a, err := scanAbsence(rows)
+		if err != nil {
+			return nil, err
+		}
+		absences = append(absences, a)
+	}
+	return absences, rows.Err()
+}
+
+func (r *AbsenceRepo) Update(ctx context.Context, id int64, update domain.AbsenceUpdate) (domain.Absence, []domain.Reassignment, error) {
+	var updated domain.Absence
+	var reassigned []domain.Reassignment
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var err error
+		updated, err = scanAbsence(tx.QueryRow(ctx, `
+			UPDATE user_absences
+			SET starts_at = COALESCE($2, starts_at),
+			    ends_at = COALESCE($3, ends_at),
+			    reason = COALESCE($4, reason),
+			    reassign_on_start = COALESCE($5, reassign_on_start)
+			WHERE id = $1
+			RETURNING `+absenceColumns,
+			id, update.StartsAt, update.EndsAt, update.Reason, update.ReassignOnStart))
+		if err != nil {
+			return mapAbsenceError(err)
+		}
+
+		reassigned, err = reassignIfStarted(ctx, tx, &updated)
+		if err != nil {
+			return err
+		}
+		return insertOutbox(ctx, tx, events.ReviewsMoved(domain.ReassignAbsence, reassigned)...)
+	})
+
+	if err != nil {
+		return domain.Absence{}, nil, err
+	}
+	return updated, reassigned, nil
+}
+
+func (r *AbsenceRepo) Delete(ctx context.Context, id int64) error {
+	ct, err := r.db.Exec(ctx, "DELETE FROM user_absences WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// ReassignStarted processes absences that have begun since the last run and
+// still wait for their reviews to be handed over.
+func (r *AbsenceRepo) ReassignStarted(ctx context.Context) ([]domain.Reassignment, error) {
+	var reassigned []domain.Reassignment
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+absenceColumns+`
+			FROM user_absences
+			WHERE reassign_on_start AND reassigned_at IS NULL
+			  AND starts_at <= NOW() AND ends_at > NOW()
+			FOR UPDATE SKIP LOCKED`)
+		if err != nil {
+			return err
+		}
+		pending, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.Absence, error) {
+			return scanAbsence(row)
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range pending {
+			ra, err := reassignIfStarted(ctx, tx, &pending[i])
+			if err != nil {
+				return err
+			}
+			reassigned = append(reassigned, ra...)
+		}
+		return insertOutbox(ctx, tx, events.ReviewsMoved(domain.ReassignAbsence, reassigned)...)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return reassigned, nil
+}
+
+func reassignIfStarted(ctx context.Context, tx pgx.Tx, a *domain.Absence) ([]domain.Reassignment, error) {
+	if !a.ReassignOnStart || a.ReassignedAt != nil {
+		return nil, nil
+	}
+
+	var started bool
+	err := tx.QueryRow(ctx, "SELECT $1::timestamptz <= NOW() AND $2::timestamptz > NOW()", a.StartsAt, a.EndsAt).
+		Scan(&started)
+	if err != nil {
+		return nil, err
+	}
+	if !started {
+		return nil, nil
+	}
+
+	reassigned, err := reassignOpenReviews(ctx, tx, []string{a.UserID}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(ctx, "UPDATE user_absences SET reassigned_at = NOW() WHERE id = $1 RETURNING reassigned_at", a.ID).
+		Scan(&a.ReassignedAt)
+	return reassigned, err
+}
+
+func mapAbsenceError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23503":
+			return domain.ErrNotFound
+		case "23514":
+			return fmt.Errorf("%w: absence must end after it starts", domain.ErrInvalidInput)
+		}
+	}
+	return err
+}
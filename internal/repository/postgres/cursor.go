@@ -0,0 +1,79 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// sortColumn is a whitelisted ORDER BY expression together with the SQL type
+// its cursor value has to be cast to.
+type sortColumn struct {
+	expr string
+	typ  string
+}
+
+// cursor points at the last row of a page: the value of the sort column and
+// the unique key used as a tie-breaker.
+type cursor struct {
+	SortBy string `json:"s"`
+	Desc   bool   `json:"d"`
+	Value  string `json:"v"`
+	Key    string `json:"k"`
+}
+
+func encodeCursor(c cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(s string, page domain.PageRequest) (*cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+	}
+
+	var c cursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+	}
+	if c.SortBy != page.SortBy || c.Desc != page.Desc {
+		return nil, fmt.Errorf("%w: cursor does not match sort order", domain.ErrInvalidInput)
+	}
+	return &c, nil
+}
+
+// keyset builds the WHERE condition and ORDER BY clause for keyset pagination over
+// col with keyCol as a tie-breaker. Cursor values are appended to args.
+func keyset(col sortColumn, keyCol string, page domain.PageRequest, args []any) (string, string, []any, error) {
+	dir, op := "ASC", ">"
+	if page.Desc {
+		dir, op = "DESC", "<"
+	}
+	order := fmt.Sprintf("ORDER BY %s %s, %s %s", col.expr, dir, keyCol, dir)
+
+	cur, err := decodeCursor(page.Cursor, page)
+	if err != nil || cur == nil {
+		return "TRUE", order, args, err
+	}
+
+	args = append(args, cur.Value, cur.Key)
+	cond := fmt.Sprintf("(%s, %s) %s (CAST($%d AS %s), $%d)",
+		col.expr, keyCol, op, len(args)-1, col.typ, len(args))
+	return cond, order, args, nil
+}
+
+func pageLimit(page domain.PageRequest) int {
+	switch {
+	case page.Limit <= 0:
+		return domain.DefaultPageLimit
+	case page.Limit > domain.MaxPageLimit:
+		return domain.MaxPageLimit
+	}
+	return page.Limit
+}
@@ -0,0 +1,263 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// exportSource describes how a dataset is read: the selected columns, the
+// time column rows are windowed by, and the unique key rows are ordered and
+// paged by. Key columns must never change for a row, so that pages neither
+// skip nor repeat rows updated during an export.
+type exportSource struct {
+	columns []domain.ExportColumn
+	exprs   []string
+	from    string
+	filter  string
+	ts      string
+	keys    []sortColumn
+}
+
+var exportSources = map[domain.ExportDataset]exportSource{
+	domain.ExportPullRequests: {
+		columns: []domain.ExportColumn{
+			{Name: "pull_request_id", Type: domain.ExportString},
+			{Name: "pull_request_name", Type: domain.ExportString},
+			{Name: "author_id", Type: domain.ExportString, Personal: true},
+			{Name: "author_username", Type: domain.ExportString, Personal: true},
+			{Name: "team_name", Type: domain.ExportString},
+			{Name: "status", Type: domain.ExportString},
+			{Name: "created_at", Type: domain.ExportTime},
+			{Name: "first_decision_at", Type: domain.ExportTime},
+			{Name: "merged_at", Type: domain.ExportTime},
+			{Name: "closed_at", Type: domain.ExportTime},
+			{Name: "reviewers", Type: domain.ExportInt},
+			{Name: "removed_slots", Type: domain.ExportInt},
+		},
+		exprs: []string{
+			"pr.id", "pr.name", "pr.author_id", "u.username", "pr.team_name", "pr.status",
+			"pr.created_at", "pr.first_decision_at", "pr.merged_at", "pr.closed_at",
+			"(SELECT COUNT(*) FROM pr_reviewers rev WHERE rev.pull_request_id = pr.id)",
+			"pr.removed_slots::bigint",
+		},
+		from:   "pull_requests pr JOIN users u ON u.id = pr.author_id",
+		filter: "TRUE",
+		ts:     "pr.created_at",
+		keys:   []sortColumn{{expr: "pr.created_at", typ: "timestamptz"}, {expr: "pr.id", typ: "text"}},
+	},
+	domain.ExportAssignments: {
+		columns: []domain.ExportColumn{
+			{Name: "pull_request_id", Type: domain.ExportString},
+			{Name: "team_name", Type: domain.ExportString},
+			{Name: "reviewer_id", Type: domain.ExportString, Personal: true},
+			{Name: "reviewer_username", Type: domain.ExportString, Personal: true},
+			{Name: "assigned_at", Type: domain.ExportTime},
+			{Name: "escalated_at", Type: domain.ExportTime},
+			{Name: "decision", Type: domain.ExportString},
+			{Name: "decided_at", Type: domain.ExportTime},
+		},
+		exprs: []string{
+			"rev.pull_request_id", "pr.team_name", "rev.reviewer_id", "u.username",
+			"rev.assigned_at", "rev.escalated_at", "rev.decision", "rev.decided_at",
+		},
+		from: `pr_reviewers rev
+			JOIN pull_requests pr ON pr.id = rev.pull_request_id
+			JOIN users u ON u.id = rev.reviewer_id`,
+		filter: "TRUE",
+		ts:     "rev.assigned_at",
+		keys:   []sortColumn{{expr: "rev.pull_request_id", typ: "text"}, {expr: "rev.reviewer_id", typ: "text"}},
+	},
+	domain.ExportDecisions: {
+		columns: []domain.ExportColumn{
+			{Name: "pull_request_id", Type: domain.ExportString},
+			{Name: "team_name", Type: domain.ExportString},
+			{Name: "reviewer_id", Type: domain.ExportString, Personal: true},
+			{Name: "reviewer_username", Type: domain.ExportString, Personal: true},
+			{Name: "decision", Type: domain.ExportString},
+			{Name: "decided_at", Type: domain.ExportTime},
+		},
+		exprs: []string{
+			"rev.pull_request_id", "pr.team_name", "rev.reviewer_id", "u.username",
+			"rev.decision", "rev.decided_at",
+		},
+		from: `pr_reviewers rev
+			JOIN pull_requests pr ON pr.id = rev.pull_request_id
+			JOIN users u ON u.id = rev.reviewer_id`,
+		filter: "rev.decided_at IS NOT NULL",
+		ts:     "rev.decided_at",
+		keys:   []sortColumn{{expr: "rev.pull_request_id", typ: "text"}, {expr: "rev.reviewer_id", typ: "text"}},
+	},
+	domain.ExportEvents: {
+		columns: []domain.ExportColumn{
+			{Name: "event_id", Type: domain.ExportInt},
+			{Name: "pull_request_id", Type: domain.ExportString},
+			{Name: "team_name", Type: domain.ExportString},
+			{Name: "event", Type: domain.ExportString},
+			{Name: "reviewer_id", Type: domain.ExportString, Personal: true},
+			{Name: "reviewer_username", Type: domain.ExportString, Personal: true},
+			{Name: "previous_reviewer_id", Type: domain.ExportString, Personal: true},
+			{Name: "created_at", Type: domain.ExportTime},
+		},
+		exprs: []string{
+			"h.id", "h.pull_request_id", "pr.team_name", "h.event", "h.reviewer_id", "u.username",
+			"h.previous_reviewer_id", "h.created_at",
+		},
+		from: `review_history h
+			JOIN pull_requests pr ON pr.id = h.pull_request_id
+			JOIN users u ON u.id = h.reviewer_id`,
+		filter: "TRUE",
+		ts:     "h.created_at",
+		keys:   []sortColumn{{expr: "h.id", typ: "bigint"}},
+	},
+}
+
+// exportCursor points at the first row of an export page by its key. AsOf is
+// when the first page was taken; later pages ignore rows timed after it.
+type exportCursor struct {
+	Dataset domain.ExportDataset `json:"d"`
+	AsOf    time.Time            `json:"a"`
+	Keys    []string             `json:"k"`
+}
+
+func decodeExportCursor(s string, dataset domain.ExportDataset) (*exportCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+	}
+
+	var c exportCursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+	}
+	if c.Dataset != dataset {
+		return nil, fmt.Errorf("%w: cursor belongs to another dataset", domain.ErrInvalidInput)
+	}
+	return &c, nil
+}
+
+// Export finds the bounds of a page of the dataset in key order and returns
+// the page with its rows left to be streamed. The first key past the page is
+// looked up first: it becomes the next cursor and bounds the row query, so the
+// cursor is known before any row is read.
+func (r *StatsRepo) Export(ctx context.Context, req domain.ExportRequest) (domain.ExportPage, error) {
+	src, ok := exportSources[req.Dataset]
+	if !ok {
+		return domain.ExportPage{}, fmt.Errorf("%w: unknown dataset %q", domain.ErrInvalidInput, req.Dataset)
+	}
+	cur, err := decodeExportCursor(req.Cursor, req.Dataset)
+	if err != nil {
+		return domain.ExportPage{}, err
+	}
+
+	asOf := time.Now()
+	if cur != nil {
+		asOf = cur.AsOf
+	}
+	to := asOf
+	if req.Window.To != nil && req.Window.To.Before(to) {
+		to = *req.Window.To
+	}
+
+	args := []any{req.Window.From, to}
+	where := fmt.Sprintf("%s AND ($1::timestamptz IS NULL OR %[2]s >= $1) AND %[2]s < $2", src.filter, src.ts)
+	if cur != nil {
+		if len(cur.Keys) != len(src.keys) {
+			return domain.ExportPage{}, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+		}
+		var bound string
+		bound, args = src.keyBound(cur.Keys, args)
+		where += fmt.Sprintf(" AND (%s) >= (%s)", src.keyList(), bound)
+	}
+
+	keyExprs := make([]string, len(src.keys))
+	next := make([]string, len(src.keys))
+	dest := make([]any, len(src.keys))
+	for i, key := range src.keys {
+		keyExprs[i] = key.expr + "::text"
+		dest[i] = &next[i]
+	}
+	err = r.db.QueryRow(ctx, fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE %s
+		ORDER BY %s
+		OFFSET $%d LIMIT 1`,
+		strings.Join(keyExprs, ", "), src.from, where, src.keyList(), len(args)+1),
+		append(slices.Clip(args), req.Limit)...).Scan(dest...)
+
+	page := domain.ExportPage{Dataset: req.Dataset, Columns: src.columns}
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return domain.ExportPage{}, err
+	default:
+		raw, _ := json.Marshal(exportCursor{Dataset: req.Dataset, AsOf: asOf, Keys: next})
+		page.NextCursor = base64.RawURLEncoding.EncodeToString(raw)
+
+		var bound string
+		bound, args = src.keyBound(next, args)
+		where += fmt.Sprintf(" AND (%s) < (%s)", src.keyList(), bound)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM %s
+		WHERE %s
+		ORDER BY %s`,
+		strings.Join(src.exprs, ", "), src.from, where, src.keyList())
+	page.Rows = func(yield func([]any, error) bool) {
+		rows, err := r.db.Query(ctx, query, args...)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			values, err := rows.Values()
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(values, nil) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+	return page, nil
+}
+
+func (s exportSource) keyList() string {
+	exprs := make([]string, len(s.keys))
+	for i, key := range s.keys {
+		exprs[i] = key.expr
+	}
+	return strings.Join(exprs, ", ")
+}
+
+// keyBound appends the key values as arguments and returns the row of
+// placeholders cast to the key types.
+func (s exportSource) keyBound(values []string, args []any) (string, []any) {
+	bounds := make([]string, len(s.keys))
+	for i, key := range s.keys {
+		args = append(args, values[i])
+		bounds[i] = fmt.Sprintf("CAST($%d AS %s)", len(args), key.typ)
+	}
+	return strings.Join(bounds, ", "), args
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type IntegrationRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewIntegrationRepo(db *pgxpool.Pool) *IntegrationRepo {
+	return &IntegrationRepo{db: db}
+}
+
+// SetUser maps the login onto the user, replacing an earlier mapping of the
+// login. An empty user id removes the mapping.
+func (r *IntegrationRepo) SetUser(ctx context.Context, provider domain.Provider, login, userID string) error {
+	login = strings.ToLower(login)
+	if userID == "" {
+		_, err := r.db.Exec(ctx, "DELETE FROM external_users WHERE provider = $1 AND login = $2", provider, login)
+		return err
+	}
+
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO external_users (provider, login, user_id) VALUES ($1, $2, $3)
+		ON CONFLICT (provider, login) DO UPDATE SET user_id = EXCLUDED.user_id`,
+		provider, login, userID)
+	return mapIntegrationError(err)
+}
+
+// SetTeam maps the code host team onto the team, replacing an earlier mapping.
+// An empty team name removes the mapping.
+func (r *IntegrationRepo) SetTeam(ctx context.Context, provider domain.Provider, externalTeam, teamName string) error {
+	if teamName == "" {
+		_, err := r.db.Exec(ctx, "DELETE FROM external_teams WHERE provider = $1 AND external_team = $2", provider, externalTeam)
+		return err
+	}
+
+	_, err := r.db.Exec(ctx, `
+		INSERT INTO external_teams (provider, external_team, team_name) VALUES ($1, $2, $3)
+		ON CONFLICT (provider, external_team) DO UPDATE SET team_name = EXCLUDED.team_name`,
+		provider, externalTeam, teamName)
+	return mapIntegrationError(err)
+}
+
+func (r *IntegrationRepo) ListUsers(ctx context.Context, provider domain.Provider) ([]domain.ExternalUser, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT provider, login, user_id FROM external_users
+		WHERE provider = $1 ORDER BY login`, provider)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.ExternalUser, error) {
+		var u domain.ExternalUser
+		err := row.Scan(&u.Provider, &u.Login, &u.UserID)
+		return u, err
+	})
+}
+
+func (r *IntegrationRepo) ListTeams(ctx context.Context, provider domain.Provider) ([]domain.ExternalTeam, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT provider, external_team, team_name FROM external_teams
+		WHERE provider = $1 ORDER BY external_team`, provider)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.ExternalTeam, error) {
+		var t domain.ExternalTeam
+		err := row.Scan(&t.Provider, &t.ExternalTeam, &t.TeamName)
+		return t, err
+	})
+}
+
+// ResolveUser returns the user the login is mapped onto.
+func (r *IntegrationRepo) ResolveUser(ctx context.Context, provider domain.Provider, login string) (string, error) {
+	var userID string
+	err := r.db.QueryRow(ctx, "SELECT user_id FROM external_users WHERE provider = $1 AND login = $2",
+		provider, strings.ToLower(login)).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", domain.ErrNotFound
+	}
+	return userID, err
+}
+
+// ResolveTeams returns the teams the code host teams are mapped onto, in the
+// order given. Unmapped teams are skipped.
+func (r *IntegrationRepo) ResolveTeams(ctx context.Context, provider domain.Provider, externalTeams []string) ([]string, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT t.team_name
+		FROM unnest($2::text[]) WITH ORDINALITY AS e(external_team, n)
+		JOIN external_teams t ON t.provider = $1 AND t.external_team = e.external_team
+		ORDER BY e.n`, provider, externalTeams)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
+// mapIntegrationError reports a mapping onto a missing user or team as not found.
+func mapIntegrationError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		return domain.ErrNotFound
+	}
+	return err
+}
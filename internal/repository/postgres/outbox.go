@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// outboxRetention is how long published events are kept for inspection.
+const outboxRetention = 7 * 24 * time.Hour
+
+// insertOutbox records events in the transaction of the change they describe.
+// It takes a transaction lock per event key first, so that rows of one key are
+// numbered in the order their transactions commit and the relay never sees a
+// later event of a pull request before an earlier one. It must be the last
+// statement of the transaction: nothing waits on other locks while holding the
+// key locks, which keeps them free of deadlocks.
+func insertOutbox(ctx context.Context, tx pgx.Tx, events ...domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(events))
+	types := make([]string, len(events))
+	keys := make([]string, len(events))
+	payloads := make([][]byte, len(events))
+	for i, e := range events {
+		ids[i], types[i], keys[i], payloads[i] = e.ID, string(e.Type), e.Key, e.Payload
+	}
+
+	locks := slices.Compact(slices.Sorted(slices.Values(keys)))
+	if _, err := tx.Exec(ctx, `
+		SELECT pg_advisory_xact_lock(hashtext('outbox'), hashtext(k))
+		FROM unnest($1::text[]) WITH ORDINALITY AS l(k, n)
+		ORDER BY n`, locks); err != nil {
+		return err
+	}
+
+	_, err := tx.Exec(ctx, `
+		INSERT INTO outbox (event_id, event, event_key, payload)
+		SELECT * FROM unnest($1::text[], $2::text[], $3::text[], $4::bytea[])`,
+		ids, types, keys, payloads)
+	return err
+}
+
+type OutboxRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewOutboxRepo(db *pgxpool.Pool) *OutboxRepo {
+	return &OutboxRepo{db: db}
+}
+
+// relayLockKey names the session advisory lock that lets one relay run at a
+// time across instances.
+const relayLockKey = "outbox relay"
+
+// Relay passes the unpublished events to publish in pages of up to limit, in
+// the order they were recorded, and marks the ones publish returns as
+// published. Events of a key that publish did not return are held back with
+// the rest of that key for the run, and later pages skip past them, so a key
+// that keeps failing does not stall the others. No transaction is open while
+// publish runs: only a session lock is held, which keeps other instances out;
+// they return at once. Published events are pruned after outboxRetention.
+func (r *OutboxRepo) Relay(ctx context.Context, limit int, publish func(context.Context, []domain.Event) ([]string, error)) (int, error) {
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Release()
+
+	var locked bool
+	err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", relayLockKey).Scan(&locked)
+	if err != nil || !locked {
+		return 0, err
+	}
+	defer func() {
+		// A connection that may still hold the lock must not go back to the pool.
+		if _, err := conn.Exec(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock(hashtext($1))", relayLockKey); err != nil {
+			_ = conn.Conn().Close(context.WithoutCancel(ctx))
+		}
+	}()
+
+	published := 0
+	var publishErr error
+	var after int64
+	// Not nil: <> ALL(NULL) would match no row.
+	blocked := []string{}
+	for {
+		rows, err := conn.Query(ctx, `
+			SELECT id, event_id, event, event_key, payload
+			FROM outbox
+			WHERE published_at IS NULL AND id > $1 AND event_key <> ALL($2)
+			ORDER BY id
+			LIMIT $3`, after, blocked, limit)
+		if err != nil {
+			return published, err
+		}
+		events, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.Event, error) {
+			var e domain.Event
+			err := row.Scan(&after, &e.ID, &e.Type, &e.Key, &e.Payload)
+			return e, err
+		})
+		if err != nil {
+			return published, err
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		ids, err := publish(ctx, events)
+		if err != nil && publishErr == nil {
+			publishErr = err
+		}
+		if len(ids) > 0 {
+			ct, err := conn.Exec(ctx, "UPDATE outbox SET published_at = NOW() WHERE event_id = ANY($1)", ids)
+			if err != nil {
+				return published, err
+			}
+			published += int(ct.RowsAffected())
+		}
+
+		done := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			done[id] = true
+		}
+		for _, e := range events {
+			if !done[e.ID] && !slices.Contains(blocked, e.Key) {
+				blocked = append(blocked, e.Key)
+			}
+		}
+
+		if len(events) < limit {
+			break
+		}
+	}
+
+	if _, err := conn.Exec(ctx, "DELETE FROM outbox WHERE published_at < $1", time.Now().Add(-outboxRetention)); err != nil {
+		return published, err
+	}
+	return published, publishErr
+}
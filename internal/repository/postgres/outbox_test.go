@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+)
+
+// testPool connects to the database in TEST_DATABASE_URL and migrates it. The
+// tests skip without it. They write to the database, so it must be one that
+// can be thrown away.
+func testPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+
+	db := stdlib.OpenDBFromPool(pool)
+	defer db.Close()
+	if err := goose.SetDialect("postgres"); err != nil {
+		t.Fatal(err)
+	}
+	if err := goose.Up(db, "../../../migrations"); err != nil {
+		t.Fatal(err)
+	}
+	return pool
+}
+
+// outboxEvents returns the types of the events recorded for a key, oldest first.
+func outboxEvents(t *testing.T, pool *pgxpool.Pool, key string) []domain.EventType {
+	t.Helper()
+	rows, err := pool.Query(context.Background(), "SELECT event FROM outbox WHERE event_key = $1 ORDER BY id", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	types, err := pgx.CollectRows(rows, pgx.RowTo[domain.EventType])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types
+}
+
+func TestPRRepoCreateOutbox(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+
+	suffix := uuid.NewString()[:8]
+	team, author, reviewer := "team-"+suffix, "author-"+suffix, "reviewer-"+suffix
+	_, err := pool.Exec(ctx, `
+		WITH t AS (INSERT INTO teams (name) VALUES ($1)),
+		u AS (INSERT INTO users (id, username, team_name) VALUES ($2, $2, $1), ($3, $3, $1))
+		INSERT INTO team_members (team_name, user_id) VALUES ($1, $2), ($1, $3)`,
+		team, author, reviewer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	repo := NewPRRepo(pool)
+	pr := domain.PullRequest{
+		ID:        "pr-" + suffix,
+		Name:      "Outbox test",
+		AuthorID:  author,
+		TeamName:  team,
+		Status:    domain.PRStatusOpen,
+		CreatedAt: time.Now(),
+	}
+
+	t.Run("rolled back", func(t *testing.T) {
+		// The unknown reviewer fails the transaction after the pull request
+		// row was written; its events must go with it.
+		failed := pr
+		failed.Reviewers = []string{reviewer, "missing-" + suffix}
+		if err := repo.Create(ctx, failed); err == nil {
+			t.Fatal("Create with an unknown reviewer succeeded")
+		}
+		if _, err := repo.GetByID(ctx, pr.ID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("GetByID = %v, want %v", err, domain.ErrNotFound)
+		}
+		if got := outboxEvents(t, pool, pr.ID); len(got) != 0 {
+			t.Errorf("outbox = %v, want no events", got)
+		}
+	})
+
+	t.Run("committed", func(t *testing.T) {
+		created := pr
+		created.Reviewers = []string{reviewer}
+		if err := repo.Create(ctx, created); err != nil {
+			t.Fatal(err)
+		}
+		want := []domain.EventType{domain.EventPRCreated, domain.EventReviewerAssigned}
+		if got := outboxEvents(t, pool, pr.ID); !slices.Equal(got, want) {
+			t.Errorf("outbox = %v, want %v", got, want)
+		}
+	})
+}
+
+func TestOutboxRelayKeepsKeyOrder(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+
+	// Other tests leave unpublished events behind; the relay would pick them up.
+	if _, err := pool.Exec(ctx, "UPDATE outbox SET published_at = NOW() WHERE published_at IS NULL"); err != nil {
+		t.Fatal(err)
+	}
+
+	suffix := uuid.NewString()[:8]
+	keyA, keyB := "a-"+suffix, "b-"+suffix
+	event := func(key string) domain.Event {
+		return domain.Event{ID: uuid.NewString(), Type: domain.EventPRCreated, Key: key, Payload: []byte(`{}`)}
+	}
+	a1, b1, a2, b2 := event(keyA), event(keyB), event(keyA), event(keyB)
+	err := withTx(ctx, pool, func(tx pgx.Tx) error {
+		return insertOutbox(ctx, tx, a1, b1, a2, b2)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The sink rejects a1 once. Publishing follows the service: after a
+	// failure the rest of the key is held back within the page.
+	errSink := errors.New("sink is down")
+	failA1 := true
+	var published []string
+	publish := func(_ context.Context, events []domain.Event) ([]string, error) {
+		var ids []string
+		var firstErr error
+		blocked := make(map[string]bool)
+		for _, e := range events {
+			if blocked[e.Key] {
+				continue
+			}
+			if e.ID == a1.ID && failA1 {
+				blocked[e.Key] = true
+				firstErr = errSink
+				continue
+			}
+			ids = append(ids, e.ID)
+			published = append(published, e.ID)
+		}
+		return ids, firstErr
+	}
+
+	repo := NewOutboxRepo(pool)
+	// A page of one makes the relay page past the blocked key.
+	n, err := repo.Relay(ctx, 1, publish)
+	if !errors.Is(err, errSink) {
+		t.Fatalf("first run error = %v, want %v", err, errSink)
+	}
+	if want := []string{b1.ID, b2.ID}; n != 2 || !slices.Equal(published, want) {
+		t.Fatalf("first run published %d: %v, want %v", n, published, want)
+	}
+
+	failA1 = false
+	published = nil
+	n, err = repo.Relay(ctx, 1, publish)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{a1.ID, a2.ID}; n != 2 || !slices.Equal(published, want) {
+		t.Fatalf("second run published %d: %v, want %v", n, published, want)
+	}
+}
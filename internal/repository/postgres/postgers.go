@@ -8,6 +8,12 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// querier runs queries on either the pool or a transaction.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
 func withTx(ctx context.Context, db *pgxpool.Pool, fn func(pgx.Tx) error) error {
 	tx, err := db.Begin(ctx)
 	if err != nil {
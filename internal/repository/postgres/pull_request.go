@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
 	"context"
 	"errors"
 
@@ -20,10 +21,14 @@ func NewPRRepo(db *pgxpool.Pool) *PRRepo {
 
 func (r *PRRepo) Create(ctx context.Context, pr domain.PullRequest) error {
 	return withTx(ctx, r.db, func(tx pgx.Tx) error {
+		if err := lockTeam(ctx, tx, pr.TeamName); err != nil {
+			return err
+		}
+
 		_, err := tx.Exec(ctx, `
-			INSERT INTO pull_requests (id, name, author_id, status, created_at)
-			VALUES ($1, $2, $3, $4, $5)`,
-			pr.ID, pr.Name, pr.AuthorID, pr.Status, pr.CreatedAt,
+			INSERT INTO pull_requests (id, name, author_id, team_name, status, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			pr.ID, pr.Name, pr.AuthorID, pr.TeamName, pr.Status, pr.CreatedAt,
 		)
 		if err != nil {
 			var pgErr *pgconn.PgError
@@ -36,26 +41,32 @@ func (r *PRRepo) Create(ctx context.Context, pr domain.PullRequest) error {
 		if len(pr.Reviewers) > 0 {
 			batch := &pgx.Batch{}
 			for _, rID := range pr.Reviewers {
-				batch.Queue("INSERT INTO pr_reviewers (pull_request_id, reviewer_id) VALUES ($1, $2)", pr.ID, rID)
+				batch.Queue("INSERT INTO pr_reviewers (pull_request_id, reviewer_id, assigned_at) VALUES ($1, $2, $3)", pr.ID, rID, pr.CreatedAt)
+				batch.Queue("INSERT INTO review_history (pull_request_id, reviewer_id, event, created_at) VALUES ($1, $2, 'ASSIGNED', $3)", pr.ID, rID, pr.CreatedAt)
 			}
 			br := tx.SendBatch(ctx, batch)
 			defer br.Close()
-			for range pr.Reviewers {
+			for range 2 * len(pr.Reviewers) {
 				if _, err := br.Exec(); err != nil {
 					return err
 				}
 			}
+			if err := br.Close(); err != nil {
+				return err
+			}
 		}
-		return nil
+
+		return insertOutbox(ctx, tx, append([]domain.Event{events.PRCreated(pr)},
+			events.ReviewersAssigned(pr.ID, pr.TeamName, domain.AssignCreated, pr.Reviewers...)...)...)
 	})
 }
 
 func (r *PRRepo) GetByID(ctx context.Context, id string) (domain.PullRequest, error) {
 	var pr domain.PullRequest
 	err := r.db.QueryRow(ctx, `
-		SELECT id, name, author_id, status, created_at, merged_at 
+		SELECT id, name, author_id, team_name, status, created_at, merged_at, closed_at, first_decision_at, removed_slots
 		FROM pull_requests WHERE id = $1`, id).
-		Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt)
+		Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.TeamName, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.ClosedAt, &pr.FirstDecisionAt, &pr.RemovedSlots)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -81,60 +92,229 @@ func (r *PRRepo) GetByID(ctx context.Context, id string) (domain.PullRequest, er
 	return pr, rows.Err()
 }
 
-func (r *PRRepo) Merge(ctx context.Context, id string) (domain.PullRequest, error) {
+// Merge marks the pull request MERGED if its reviewers' decisions satisfy the
+// policy. Merging an already merged pull request is a no-op.
+func (r *PRRepo) Merge(ctx context.Context, id string, policy domain.MergePolicy) (domain.PullRequest, bool, error) {
 	var pr domain.PullRequest
+	merged := false
 	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, `
+		var status domain.PullRequestStatus
+		err := tx.QueryRow(ctx, "SELECT status FROM pull_requests WHERE id = $1 FOR UPDATE", id).Scan(&status)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if status != domain.PRStatusMerged && policy != domain.MergeAny {
+			rows, err := tx.Query(ctx, "SELECT COALESCE(decision, '') FROM pr_reviewers WHERE pull_request_id = $1", id)
+			if err != nil {
+				return err
+			}
+			decisions, err := pgx.CollectRows(rows, pgx.RowTo[domain.ReviewDecision])
+			if err != nil {
+				return err
+			}
+			if !policy.Allows(decisions) {
+				return domain.ErrMergeBlocked
+			}
+		}
+
+		ct, err := tx.Exec(ctx, `
 			UPDATE pull_requests 
 			SET status = 'MERGED', merged_at = NOW() 
-			WHERE id = $1 AND status = 'OPEN'`, id)
+			WHERE id = $1 AND status IN ('OPEN', 'CLOSED')`, id)
 		if err != nil {
 			return err
 		}
+		merged = ct.RowsAffected() > 0
 
 		err = tx.QueryRow(ctx, `
-			SELECT id, name, author_id, status, created_at, merged_at 
+			SELECT id, name, author_id, team_name, status, created_at, merged_at, closed_at, first_decision_at
 			FROM pull_requests WHERE id = $1`, id).
-			Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt)
+			Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.TeamName, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.ClosedAt, &pr.FirstDecisionAt)
 
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.ErrNotFound
 		}
-		return err
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, "SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1", id)
+		if err != nil {
+			return err
+		}
+		pr.Reviewers, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil || !merged {
+			return err
+		}
+
+		return insertOutbox(ctx, tx, events.PRMerged(pr))
 	})
 
 	if err != nil {
-		return domain.PullRequest{}, err
+		return domain.PullRequest{}, false, err
 	}
+	return pr, merged, nil
+}
 
-	rows, err := r.db.Query(ctx, "SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1", id)
+func (r *PRRepo) UpdateName(ctx context.Context, id, name string) error {
+	ct, err := r.db.Exec(ctx, "UPDATE pull_requests SET name = $2 WHERE id = $1", id, name)
 	if err != nil {
-		return domain.PullRequest{}, err
+		return err
 	}
-	defer rows.Close()
+	if ct.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
 
-	for rows.Next() {
-		var rid string
-		rows.Scan(&rid)
-		pr.Reviewers = append(pr.Reviewers, rid)
+// SetClosed closes an OPEN pull request or reopens a CLOSED one. It is
+// idempotent and reports whether this call changed the status. A merged pull
+// request can be neither closed nor reopened, and one whose team was deleted
+// cannot be reopened.
+func (r *PRRepo) SetClosed(ctx context.Context, id string, closed bool) (domain.PullRequest, bool, error) {
+	to := domain.PRStatusClosed
+	if !closed {
+		to = domain.PRStatusOpen
 	}
 
-	return pr, nil
+	changed := false
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var status, teamName string
+		err := tx.QueryRow(ctx, "SELECT status, team_name FROM pull_requests WHERE id = $1 FOR UPDATE", id).Scan(&status, &teamName)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+		switch domain.PullRequestStatus(status) {
+		case domain.PRStatusMerged:
+			return domain.ErrPRMerged
+		case to:
+			return nil
+		}
+		// A pull request of a deleted team stays closed.
+		if !closed {
+			if err := lockTeam(ctx, tx, teamName); err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE pull_requests
+			SET status = $2, closed_at = CASE WHEN $3 THEN NOW() END
+			WHERE id = $1`, id, to, closed)
+		changed = err == nil
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, false, err
+	}
+
+	pr, err := r.GetByID(ctx, id)
+	return pr, changed, err
 }
 
 func (r *PRRepo) UpdateReviewer(ctx context.Context, prID, oldID, newID string) error {
-	ct, err := r.db.Exec(ctx, `
-		UPDATE pr_reviewers 
-		SET reviewer_id = $1 
-		WHERE pull_request_id = $2 AND reviewer_id = $3`,
-		newID, prID, oldID)
-	if err != nil {
+	return withTx(ctx, r.db, func(tx pgx.Tx) error {
+		ct, err := tx.Exec(ctx, `
+			UPDATE pr_reviewers 
+			SET reviewer_id = $1, assigned_at = NOW(), escalated_at = NULL, decision = NULL, decided_at = NULL
+			WHERE pull_request_id = $2 AND reviewer_id = $3`,
+			newID, prID, oldID)
+		if err != nil {
+			return err
+		}
+		if ct.RowsAffected() == 0 {
+			return domain.ErrNotAssigned
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO review_history (pull_request_id, reviewer_id, previous_reviewer_id, event)
+			VALUES ($1, $2, $3, 'REASSIGNED')`, prID, newID, oldID)
+		if err != nil {
+			return err
+		}
+
+		return insertOutbox(ctx, tx, events.ReviewerReassigned(domain.ReassignManual,
+			domain.Reassignment{PullRequestID: prID, OldReviewerID: oldID, NewReviewerID: newID, Outcome: domain.OutcomeReplaced}))
+	})
+}
+
+// RemoveReviewer takes the reviewer off the pull request without a replacement.
+// A parked slot stays open for backfill; otherwise the pull request keeps one
+// reviewer less for good. Either way the change is recorded in review_history.
+func (r *PRRepo) RemoveReviewer(ctx context.Context, prID, reviewerID string, park bool) error {
+	return withTx(ctx, r.db, func(tx pgx.Tx) error {
+		ct, err := tx.Exec(ctx, `
+			DELETE FROM pr_reviewers
+			WHERE pull_request_id = $1 AND reviewer_id = $2`, prID, reviewerID)
+		if err != nil {
+			return err
+		}
+		if ct.RowsAffected() == 0 {
+			return domain.ErrNotAssigned
+		}
+
+		outcome := domain.OutcomeParked
+		if !park {
+			outcome = domain.OutcomeRemoved
+			_, err = tx.Exec(ctx, "UPDATE pull_requests SET removed_slots = removed_slots + 1 WHERE id = $1", prID)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO review_history (pull_request_id, reviewer_id, event)
+			VALUES ($1, $2, $3)`, prID, reviewerID, string(outcome))
+		if err != nil {
+			return err
+		}
+
+		return insertOutbox(ctx, tx, events.ReviewerReassigned(domain.ReassignManual,
+			domain.Reassignment{PullRequestID: prID, OldReviewerID: reviewerID, Outcome: outcome}))
+	})
+}
+
+// RecordDecision stores the reviewer's decision on an OPEN pull request and the
+// time of the first decision on the pull request. A later decision replaces the
+// reviewer's earlier one.
+func (r *PRRepo) RecordDecision(ctx context.Context, prID, reviewerID string, decision domain.ReviewDecision) error {
+	return withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var status string
+		err := tx.QueryRow(ctx, "SELECT status FROM pull_requests WHERE id = $1 FOR UPDATE", prID).Scan(&status)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+		switch domain.PullRequestStatus(status) {
+		case domain.PRStatusClosed:
+			return domain.ErrPRClosed
+		case domain.PRStatusMerged:
+			return domain.ErrPRMerged
+		}
+
+		ct, err := tx.Exec(ctx, `
+			UPDATE pr_reviewers SET decision = $3, decided_at = NOW()
+			WHERE pull_request_id = $1 AND reviewer_id = $2`, prID, reviewerID, decision)
+		if err != nil {
+			return err
+		}
+		if ct.RowsAffected() == 0 {
+			return domain.ErrNotAssigned
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE pull_requests SET first_decision_at = COALESCE(first_decision_at, NOW())
+			WHERE id = $1`, prID)
 		return err
-	}
-	if ct.RowsAffected() == 0 {
-		return domain.ErrNotAssigned
-	}
-	return nil
+	})
 }
 
 func (r *PRRepo) GetByReviewerID(ctx context.Context, reviewerID string) ([]domain.PullRequest, error) {
@@ -160,3 +340,212 @@ func (r *PRRepo) GetByReviewerID(ctx context.Context, reviewerID string) ([]doma
 	}
 	return prs, rows.Err()
 }
+
+func (r *PRRepo) GetAssignments(ctx context.Context, prID string) ([]domain.ReviewAssignment, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT reviewer_id, assigned_at
+		FROM pr_reviewers
+		WHERE pull_request_id = $1
+		ORDER BY assigned_at, reviewer_id`, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []domain.ReviewAssignment
+	for rows.Next() {
+		var a domain.ReviewAssignment
+		if err := rows.Scan(&a.ReviewerID, &a.AssignedAt); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, a)
+	}
+	return assignments, rows.Err()
+}
+
+// ListOpenReviews returns the reviewer slots of OPEN pull requests that are
+// neither escalated nor decided yet, with what escalation needs to know about
+// the pull request and the reviewer.
+func (r *PRRepo) ListOpenReviews(ctx context.Context) ([]domain.OpenReview, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT rev.pull_request_id, pr.team_name, pr.author_id,
+		       ARRAY(SELECT r2.reviewer_id FROM pr_reviewers r2 WHERE r2.pull_request_id = pr.id),
+		       rev.reviewer_id, rev.assigned_at, u.timezone, u.work_start_minute, u.work_end_minute
+		FROM pr_reviewers rev
+		JOIN pull_requests pr ON pr.id = rev.pull_request_id
+		JOIN users u ON u.id = rev.reviewer_id
+		WHERE pr.status = 'OPEN' AND rev.escalated_at IS NULL AND rev.decided_at IS NULL
+		ORDER BY rev.assigned_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.OpenReview
+	for rows.Next() {
+		var o domain.OpenReview
+		if err := rows.Scan(&o.PullRequestID, &o.TeamName, &o.AuthorID, &o.Reviewers, &o.ReviewerID, &o.AssignedAt,
+			&o.ReviewerHours.Timezone, &o.ReviewerHours.Start, &o.ReviewerHours.End); err != nil {
+			return nil, err
+		}
+		result = append(result, o)
+	}
+	return result, rows.Err()
+}
+
+// Escalate marks the overdue slots as escalated and adds the leads as reviewers,
+// all in one transaction. It returns the escalations that took effect; slots
+// that were already escalated, reassigned or merged meanwhile are left out.
+func (r *PRRepo) Escalate(ctx context.Context, escalations []domain.Escalation) ([]domain.Escalation, error) {
+	if len(escalations) == 0 {
+		return nil, nil
+	}
+	prIDs := make([]string, len(escalations))
+	reviewerIDs := make([]string, len(escalations))
+	leadIDs := make([]string, len(escalations))
+	for i, e := range escalations {
+		prIDs[i], reviewerIDs[i], leadIDs[i] = e.PullRequestID, e.OverdueReviewerID, e.LeadID
+	}
+
+	var result []domain.Escalation
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		result = nil
+		rows, err := tx.Query(ctx, `
+			UPDATE pr_reviewers rev SET escalated_at = NOW()
+			FROM pull_requests pr, unnest($1::text[], $2::text[], $3::text[]) AS e(pr_id, reviewer_id, lead_id)
+			WHERE pr.id = rev.pull_request_id AND pr.status = 'OPEN'
+			  AND rev.pull_request_id = e.pr_id AND rev.reviewer_id = e.reviewer_id AND rev.escalated_at IS NULL
+			RETURNING e.pr_id, e.reviewer_id, e.lead_id`,
+			prIDs, reviewerIDs, leadIDs)
+		if err != nil {
+			return err
+		}
+		result, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.Escalation, error) {
+			var e domain.Escalation
+			err := row.Scan(&e.PullRequestID, &e.OverdueReviewerID, &e.LeadID)
+			return e, err
+		})
+		if err != nil || len(result) == 0 {
+			return err
+		}
+
+		prIDs, leadIDs = prIDs[:0], leadIDs[:0]
+		for _, e := range result {
+			prIDs = append(prIDs, e.PullRequestID)
+			leadIDs = append(leadIDs, e.LeadID)
+		}
+		// A lead who already reviews the pull request is not added twice.
+		rows, err = tx.Query(ctx, `
+			WITH added AS (
+				INSERT INTO pr_reviewers (pull_request_id, reviewer_id, assigned_at)
+				SELECT pr_id, lead_id, NOW() FROM unnest($1::text[], $2::text[]) AS e(pr_id, lead_id)
+				ON CONFLICT DO NOTHING
+				RETURNING pull_request_id, reviewer_id
+			), history AS (
+				INSERT INTO review_history (pull_request_id, reviewer_id, event)
+				SELECT pull_request_id, reviewer_id, 'ESCALATED' FROM added
+			)
+			SELECT added.pull_request_id, pr.team_name, added.reviewer_id
+			FROM added JOIN pull_requests pr ON pr.id = added.pull_request_id`,
+			prIDs, leadIDs)
+		if err != nil {
+			return err
+		}
+		var evs []domain.Event
+		var prID, teamName, leadID string
+		_, err = pgx.ForEachRow(rows, []any{&prID, &teamName, &leadID}, func() error {
+			evs = append(evs, events.ReviewersAssigned(prID, teamName, domain.AssignEscalation, leadID)...)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return insertOutbox(ctx, tx, evs...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ListOpen returns the OPEN pull requests owned by the team with their reviewers.
+// An empty teamName covers every team.
+func (r *PRRepo) ListOpen(ctx context.Context, teamName string) ([]domain.PullRequest, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT pr.id, pr.name, pr.author_id, pr.team_name, pr.status, pr.created_at, pr.removed_slots,
+		       COALESCE(array_agg(rev.reviewer_id ORDER BY rev.reviewer_id) FILTER (WHERE rev.reviewer_id IS NOT NULL), '{}')
+		FROM pull_requests pr
+		LEFT JOIN pr_reviewers rev ON rev.pull_request_id = pr.id
+		WHERE pr.status = 'OPEN' AND ($1 = '' OR pr.team_name = $1)
+		GROUP BY pr.id
+		ORDER BY pr.created_at, pr.id`, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.PullRequest
+	for rows.Next() {
+		var pr domain.PullRequest
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.TeamName, &pr.Status, &pr.CreatedAt, &pr.RemovedSlots, &pr.Reviewers); err != nil {
+			return nil, err
+		}
+		result = append(result, pr)
+	}
+	return result, rows.Err()
+}
+
+// Backfill adds candidates in order as reviewers of the OPEN pull request until
+// it has target reviewers, skipping those already assigned. The additions are
+// recorded in review_history. It returns the added reviewers.
+func (r *PRRepo) Backfill(ctx context.Context, prID string, candidateIDs []string, target int) ([]string, error) {
+	var added []string
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var status, teamName string
+		err := tx.QueryRow(ctx, "SELECT status, team_name FROM pull_requests WHERE id = $1 FOR UPDATE", prID).Scan(&status, &teamName)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+		if status != string(domain.PRStatusOpen) {
+			return nil
+		}
+
+		rows, err := tx.Query(ctx, `
+			WITH current AS (
+				SELECT reviewer_id FROM pr_reviewers WHERE pull_request_id = $1
+			),
+			picked AS (
+				SELECT c.id
+				FROM unnest($2::text[]) WITH ORDINALITY AS c(id, n)
+				WHERE c.id NOT IN (SELECT reviewer_id FROM current)
+				ORDER BY c.n
+				LIMIT GREATEST($3 - (SELECT COUNT(*) FROM current), 0)
+			)
+			INSERT INTO pr_reviewers (pull_request_id, reviewer_id, assigned_at)
+			SELECT $1, id, NOW() FROM picked
+			RETURNING reviewer_id`, prID, candidateIDs, target)
+		if err != nil {
+			return err
+		}
+		added, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil || len(added) == 0 {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO review_history (pull_request_id, reviewer_id, event)
+			SELECT $1, unnest($2::text[]), 'BACKFILLED'`, prID, added)
+		if err != nil {
+			return err
+		}
+
+		return insertOutbox(ctx, tx, events.ReviewersAssigned(prID, teamName, domain.AssignBackfill, added...)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
@@ -0,0 +1,296 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// openSlot is a reviewer slot that reassignOpenReviews has to hand over.
+type openSlot struct {
+	pullRequestID string
+	reviewerID    string
+	authorID      string
+	teamName      string
+	// reviewers are everyone currently reviewing the pull request.
+	reviewers []string
+}
+
+// reassignOpenReviews hands the given reviewers' slots on OPEN pull requests over
+// to other users, limited to pull requests owned by teamName unless it is empty.
+// Every slot goes to a distinct user picked the way new reviewers are: from the
+// available members of the team that owns the PR, or its escalation pool, who
+// are neither the author nor already reviewing it, ordered by the team's
+// reviewer selection and then by open reviews, counting the slots handed out
+// earlier in the call so that a bulk hand-over is spread out. A slot nobody can
+// take is parked for backfill or removed, as the team's no-candidate policy
+// says. Every change is logged to review_history.
+func reassignOpenReviews(ctx context.Context, tx pgx.Tx, reviewerIDs []string, teamName string) ([]domain.Reassignment, error) {
+	slots, err := lockOpenSlots(ctx, tx, reviewerIDs, teamName)
+	if err != nil || len(slots) == 0 {
+		return nil, err
+	}
+
+	pools := newCandidatePools(tx)
+	// Reviewers picked earlier in this call already hold a slot on the PR.
+	picked := make(map[string][]string)
+	now := time.Now()
+
+	result := make([]domain.Reassignment, 0, len(slots))
+	for _, slot := range slots {
+		team, err := pools.team(ctx, slot.teamName)
+		if err != nil {
+			return nil, err
+		}
+
+		exclude := map[string]bool{slot.authorID: true}
+		for _, id := range reviewerIDs {
+			exclude[id] = true
+		}
+		for _, id := range slot.reviewers {
+			exclude[id] = true
+		}
+		for _, id := range picked[slot.pullRequestID] {
+			exclude[id] = true
+		}
+		candidates, err := pools.candidates(ctx, team, exclude)
+		if err != nil {
+			return nil, err
+		}
+
+		ra := domain.Reassignment{PullRequestID: slot.pullRequestID, TeamName: slot.teamName, OldReviewerID: slot.reviewerID}
+		switch {
+		case len(candidates) > 0:
+			load, err := pools.load(ctx, candidates)
+			if err != nil {
+				return nil, err
+			}
+			domain.OrderCandidatesByLoad(candidates, team.Settings.ReviewerSelection, now, load)
+			ra.NewReviewerID = candidates[0].ID
+			ra.Outcome = domain.OutcomeReplaced
+			picked[slot.pullRequestID] = append(picked[slot.pullRequestID], ra.NewReviewerID)
+			load[ra.NewReviewerID]++
+		case team.Settings.NoCandidatePolicy == domain.NoCandidateRemove:
+			ra.Outcome = domain.OutcomeRemoved
+		default:
+			ra.Outcome = domain.OutcomeParked
+		}
+		result = append(result, ra)
+	}
+
+	if err := applyReassignments(ctx, tx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// candidatePools caches the teams, available users and their open reviews that
+// reassignOpenReviews looks at, so that each is read once per call.
+type candidatePools struct {
+	tx       pgx.Tx
+	teams    map[string]domain.Team
+	members  map[string][]domain.User
+	subtrees map[string][]domain.User
+	// open counts slots on OPEN pull requests per user read so far.
+	open map[string]int
+}
+
+func newCandidatePools(tx pgx.Tx) *candidatePools {
+	return &candidatePools{
+		tx:       tx,
+		teams:    make(map[string]domain.Team),
+		members:  make(map[string][]domain.User),
+		subtrees: make(map[string][]domain.User),
+		open:     make(map[string]int),
+	}
+}
+
+// load returns the open review counts, reading those of candidates not seen
+// before. The caller updates the returned map as it hands out slots.
+func (p *candidatePools) load(ctx context.Context, candidates []domain.User) (map[string]int, error) {
+	var missing []string
+	for _, c := range candidates {
+		if _, ok := p.open[c.ID]; !ok {
+			missing = append(missing, c.ID)
+		}
+	}
+	if len(missing) == 0 {
+		return p.open, nil
+	}
+
+	rows, err := p.tx.Query(ctx, `
+		SELECT u.id, COUNT(pr.id)
+		FROM unnest($1::text[]) AS u(id)
+		LEFT JOIN pr_reviewers rev ON rev.reviewer_id = u.id
+		LEFT JOIN pull_requests pr ON pr.id = rev.pull_request_id AND pr.status = 'OPEN'
+		GROUP BY u.id`, missing)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			id string
+			n  int
+		)
+		if err := rows.Scan(&id, &n); err != nil {
+			return nil, err
+		}
+		p.open[id] = n
+	}
+	return p.open, rows.Err()
+}
+
+func (p *candidatePools) team(ctx context.Context, name string) (domain.Team, error) {
+	if team, ok := p.teams[name]; ok {
+		return team, nil
+	}
+	team, err := getTeamInfo(ctx, p.tx, name)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	p.teams[name] = team
+	return team, nil
+}
+
+// candidates returns available members of the team without the excluded users.
+// If there are none and the team escalates to its parent, the parent team's
+// whole subtree is used instead, going further up while still empty.
+func (p *candidatePools) candidates(ctx context.Context, team domain.Team, exclude map[string]bool) ([]domain.User, error) {
+	users, ok := p.members[team.Name]
+	if !ok {
+		var err error
+		if users, err = getAvailableUsersByTeam(ctx, p.tx, team.Name); err != nil {
+			return nil, err
+		}
+		p.members[team.Name] = users
+	}
+	candidates := withoutUsers(users, exclude)
+
+	settings := team.Settings
+	for depth := 0; len(candidates) == 0 && settings.EscalateToParent && team.ParentName != nil && depth < maxTeamDepth; depth++ {
+		parent, err := p.team(ctx, *team.ParentName)
+		if err != nil {
+			return nil, err
+		}
+		team = parent
+		users, ok := p.subtrees[team.Name]
+		if !ok {
+			if users, err = getAvailableUsersInSubtree(ctx, p.tx, team.Name); err != nil {
+				return nil, err
+			}
+			p.subtrees[team.Name] = users
+		}
+		candidates = withoutUsers(users, exclude)
+		settings = team.Settings
+	}
+	return candidates, nil
+}
+
+func withoutUsers(users []domain.User, exclude map[string]bool) []domain.User {
+	result := make([]domain.User, 0, len(users))
+	for _, u := range users {
+		if !exclude[u.ID] {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+// lockOpenSlots returns the reviewers' slots on OPEN pull requests, locked for
+// the rest of the transaction.
+func lockOpenSlots(ctx context.Context, tx pgx.Tx, reviewerIDs []string, teamName string) ([]openSlot, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT rev.pull_request_id, rev.reviewer_id, pr.author_id, pr.team_name,
+		       ARRAY(SELECT cur.reviewer_id FROM pr_reviewers cur WHERE cur.pull_request_id = pr.id)
+		FROM pr_reviewers rev
+		JOIN pull_requests pr ON pr.id = rev.pull_request_id
+		WHERE rev.reviewer_id = ANY($1) AND pr.status = 'OPEN'
+		  AND ($2 = '' OR pr.team_name = $2)
+		ORDER BY rev.pull_request_id, rev.reviewer_id
+		FOR UPDATE OF rev`, reviewerIDs, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (openSlot, error) {
+		var s openSlot
+		err := row.Scan(&s.pullRequestID, &s.reviewerID, &s.authorID, &s.teamName, &s.reviewers)
+		return s, err
+	})
+}
+
+// applyReassignments stores the outcome of every slot: replaced slots change
+// hands, parked and removed ones are dropped, and removed ones also lower the
+// pull request's reviewer target.
+func applyReassignments(ctx context.Context, tx pgx.Tx, reassigned []domain.Reassignment) error {
+	var movedPRs, movedOld, movedNew []string
+	var droppedPRs, droppedOld, removedPRs []string
+	var historyPRs, historyEvents []string
+	var historyReviewers []string
+	var historyPrevious []*string
+	for _, ra := range reassigned {
+		historyPRs = append(historyPRs, ra.PullRequestID)
+		historyEvents = append(historyEvents, string(ra.Outcome))
+		switch ra.Outcome {
+		case domain.OutcomeReplaced:
+			movedPRs = append(movedPRs, ra.PullRequestID)
+			movedOld = append(movedOld, ra.OldReviewerID)
+			movedNew = append(movedNew, ra.NewReviewerID)
+			historyEvents[len(historyEvents)-1] = "REASSIGNED"
+			historyReviewers = append(historyReviewers, ra.NewReviewerID)
+			historyPrevious = append(historyPrevious, &ra.OldReviewerID)
+		default:
+			droppedPRs = append(droppedPRs, ra.PullRequestID)
+			droppedOld = append(droppedOld, ra.OldReviewerID)
+			if ra.Outcome == domain.OutcomeRemoved {
+				removedPRs = append(removedPRs, ra.PullRequestID)
+			}
+			historyReviewers = append(historyReviewers, ra.OldReviewerID)
+			historyPrevious = append(historyPrevious, nil)
+		}
+	}
+
+	_, err := tx.Exec(ctx, `
+		UPDATE pr_reviewers rev
+		SET reviewer_id = m.new_id, assigned_at = NOW(), escalated_at = NULL,
+		    decision = NULL, decided_at = NULL
+		FROM unnest($1::text[], $2::text[], $3::text[]) AS m(pr_id, old_id, new_id)
+		WHERE rev.pull_request_id = m.pr_id AND rev.reviewer_id = m.old_id`,
+		movedPRs, movedOld, movedNew)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		DELETE FROM pr_reviewers rev
+		USING unnest($1::text[], $2::text[]) AS d(pr_id, old_id)
+		WHERE rev.pull_request_id = d.pr_id AND rev.reviewer_id = d.old_id`,
+		droppedPRs, droppedOld)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE pull_requests pr SET removed_slots = pr.removed_slots + d.n
+		FROM (SELECT pr_id, COUNT(*) AS n FROM unnest($1::text[]) AS pr_id GROUP BY pr_id) d
+		WHERE pr.id = d.pr_id`, removedPRs)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `
+		INSERT INTO review_history (pull_request_id, reviewer_id, previous_reviewer_id, event)
+		SELECT * FROM unnest($1::text[], $2::text[], $3::text[], $4::text[])`,
+		historyPRs, historyReviewers, historyPrevious, historyEvents)
+	return err
+}
+
+// countOpenReviews counts the reviewer's slots on OPEN pull requests owned by the team.
+func countOpenReviews(ctx context.Context, tx pgx.Tx, reviewerID, teamName string) (int, error) {
+	var n int
+	err := tx.QueryRow(ctx, `
+		SELECT COUNT(*)
+		FROM pr_reviewers rev
+		JOIN pull_requests pr ON pr.id = rev.pull_request_id
+		WHERE rev.reviewer_id = $1 AND pr.team_name = $2 AND pr.status = 'OPEN'`, reviewerID, teamName).Scan(&n)
+	return n, err
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// rebuildUserDailyQuery recomputes review_stats_user_daily for the days in
+// [$1, $2). Assignments and reassignments count on the day they were logged,
+// merged reviews on the day the pull request was merged.
+const rebuildUserDailyQuery = `
+	INSERT INTO review_stats_user_daily (day, team_name, user_id, assignments, reassigned_in, reassigned_away, merged_reviewed)
+	SELECT day, team_name, user_id, SUM(assignments), SUM(reassigned_in), SUM(reassigned_away), SUM(merged_reviewed)
+	FROM (
+		SELECT (h.created_at AT TIME ZONE 'UTC')::date AS day, pr.team_name, h.reviewer_id AS user_id,
+		       1 AS assignments, (h.event = 'REASSIGNED')::int AS reassigned_in,
+		       0 AS reassigned_away, 0 AS merged_reviewed
+		FROM review_history h
+		JOIN pull_requests pr ON pr.id = h.pull_request_id
+		WHERE h.event IN ('ASSIGNED', 'BACKFILLED', 'ESCALATED', 'REASSIGNED')
+		  AND h.created_at >= $1 AND h.created_at < $2
+
+		UNION ALL
+
+		SELECT (h.created_at AT TIME ZONE 'UTC')::date, pr.team_name, h.previous_reviewer_id, 0, 0, 1, 0
+		FROM review_history h
+		JOIN pull_requests pr ON pr.id = h.pull_request_id
+		WHERE h.previous_reviewer_id IS NOT NULL
+		  AND h.created_at >= $1 AND h.created_at < $2
+
+		UNION ALL
+
+		SELECT (pr.merged_at AT TIME ZONE 'UTC')::date, pr.team_name, rev.reviewer_id, 0, 0, 0, 1
+		FROM pr_reviewers rev
+		JOIN pull_requests pr ON pr.id = rev.pull_request_id
+		WHERE pr.merged_at >= $1 AND pr.merged_at < $2
+	) facts
+	GROUP BY day, team_name, user_id`
+
+// rebuildTeamDailyQuery recomputes review_stats_team_daily for the days in [$1, $2).
+const rebuildTeamDailyQuery = `
+	INSERT INTO review_stats_team_daily (day, team_name, prs_created, prs_merged)
+	SELECT day, team_name, SUM(created), SUM(merged)
+	FROM (
+		SELECT (created_at AT TIME ZONE 'UTC')::date AS day, team_name, 1 AS created, 0 AS merged
+		FROM pull_requests
+		WHERE created_at >= $1 AND created_at < $2
+
+		UNION ALL
+
+		SELECT (merged_at AT TIME ZONE 'UTC')::date, team_name, 0, 1
+		FROM pull_requests
+		WHERE merged_at >= $1 AND merged_at < $2
+	) facts
+	GROUP BY day, team_name`
+
+// rollupGraceDays is how many of the latest days are recomputed on every refresh
+// besides today, so that transactions committed just after midnight are not missed.
+const rollupGraceDays = 1
+
+// RebuildRollups recomputes the rollups of the UTC days in [from, to).
+func (r *StatsRepo) RebuildRollups(ctx context.Context, from, to time.Time) error {
+	return withTx(ctx, r.db, func(tx pgx.Tx) error {
+		if err := lockRollups(ctx, tx); err != nil {
+			return err
+		}
+		if err := rebuildDays(ctx, tx, from, to); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, "DELETE FROM stats_dirty_days WHERE day >= $1::date AND day < $2::date", from, to)
+		return err
+	})
+}
+
+// RefreshRollups brings the rollups up to date: every day after the sealed ones
+// up to today is recomputed, and so are the sealed days that changed since,
+// which triggers record in stats_dirty_days. It returns the number of days recomputed.
+func (r *StatsRepo) RefreshRollups(ctx context.Context, now time.Time) (int, error) {
+	today := domain.StartOfDay(now)
+	days := 0
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		if err := lockRollups(ctx, tx); err != nil {
+			return err
+		}
+
+		var sealed, earliest *time.Time
+		if err := tx.QueryRow(ctx, "SELECT sealed_through FROM stats_rollup_state").Scan(&sealed); err != nil {
+			return err
+		}
+		start := today
+		if sealed != nil {
+			start = sealed.AddDate(0, 0, 1)
+		} else {
+			err := tx.QueryRow(ctx, "SELECT MIN(created_at) FROM pull_requests").Scan(&earliest)
+			if err != nil {
+				return err
+			}
+			if earliest != nil {
+				start = domain.StartOfDay(*earliest)
+			}
+		}
+
+		if start.After(today) {
+			start = today
+		}
+		end := today.AddDate(0, 0, 1)
+		if err := rebuildDays(ctx, tx, start, end); err != nil {
+			return err
+		}
+		days = int(end.Sub(start) / (24 * time.Hour))
+
+		rows, err := tx.Query(ctx, "DELETE FROM stats_dirty_days RETURNING day")
+		if err != nil {
+			return err
+		}
+		dirty, err := pgx.CollectRows(rows, pgx.RowTo[time.Time])
+		if err != nil {
+			return err
+		}
+		for _, day := range dirty {
+			if !day.Before(start) {
+				continue
+			}
+			if err := rebuildDays(ctx, tx, day, day.AddDate(0, 0, 1)); err != nil {
+				return err
+			}
+			days++
+		}
+
+		_, err = tx.Exec(ctx, "UPDATE stats_rollup_state SET sealed_through = $1::date", today.AddDate(0, 0, -1-rollupGraceDays))
+		return err
+	})
+	return days, err
+}
+
+// lockRollups serializes rollup rebuilds.
+func lockRollups(ctx context.Context, tx pgx.Tx) error {
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('stats_rollups'))")
+	return err
+}
+
+func rebuildDays(ctx context.Context, tx pgx.Tx, from, to time.Time) error {
+	if _, err := tx.Exec(ctx, "DELETE FROM review_stats_user_daily WHERE day >= $1::date AND day < $2::date", from, to); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, "DELETE FROM review_stats_team_daily WHERE day >= $1::date AND day < $2::date", from, to); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, rebuildUserDailyQuery, from, to); err != nil {
+		return err
+	}
+	_, err := tx.Exec(ctx, rebuildTeamDailyQuery, from, to)
+	return err
+}
@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type StatsRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewStatsRepo(db *pgxpool.Pool) *StatsRepo {
+	return &StatsRepo{db: db}
+}
+
+// reviewerStatsQuery sums the daily rollups of the days in [$1, $2) and adds the
+// current open load, limited to pull requests owned by $3 unless it is empty.
+// The sums are taken both per reviewer and per owning team.
+const reviewerStatsQuery = `
+	WITH facts AS (
+		SELECT user_id, team_name, assignments, reassigned_in, reassigned_away, merged_reviewed, 0 AS open_load
+		FROM review_stats_user_daily
+		WHERE ($1::timestamptz IS NULL OR day >= ($1::timestamptz AT TIME ZONE 'UTC')::date)
+		  AND ($2::timestamptz IS NULL OR day < ($2::timestamptz AT TIME ZONE 'UTC')::date)
+		  AND ($3 = '' OR team_name = $3)
+
+		UNION ALL
+
+		SELECT rev.reviewer_id, pr.team_name, 0, 0, 0, 0, 1
+		FROM pr_reviewers rev
+		JOIN pull_requests pr ON pr.id = rev.pull_request_id
+		WHERE pr.status = 'OPEN' AND ($3 = '' OR pr.team_name = $3)
+	),
+	totals AS (
+		SELECT user_id, team_name, GROUPING(user_id) = 1 AS is_team,
+		       SUM(assignments) AS assignments, SUM(reassigned_away) AS reassigned_away,
+		       SUM(reassigned_in) AS reassigned_in, SUM(merged_reviewed) AS merged_reviewed,
+		       SUM(open_load) AS open_load
+		FROM facts
+		GROUP BY GROUPING SETS ((user_id), (team_name))
+	)
+	SELECT t.is_team, COALESCE(t.user_id, ''), COALESCE(u.username, ''), COALESCE(t.team_name, ''),
+	       t.assignments, t.reassigned_away, t.reassigned_in, t.merged_reviewed, t.open_load
+	FROM totals t
+	LEFT JOIN users u ON u.id = t.user_id
+	ORDER BY t.is_team, t.team_name, t.user_id`
+
+// ReviewerStats returns review workload per reviewer and per owning team.
+func (r *StatsRepo) ReviewerStats(ctx context.Context, teamName string, window domain.StatsWindow) (domain.ReviewerStats, error) {
+	rows, err := r.db.Query(ctx, reviewerStatsQuery, window.From, window.To, teamName)
+	if err != nil {
+		return domain.ReviewerStats{}, err
+	}
+	defer rows.Close()
+
+	result := domain.ReviewerStats{
+		Users: []domain.UserReviewStats{},
+		Teams: []domain.TeamReviewStats{},
+	}
+	for rows.Next() {
+		var (
+			isTeam           bool
+			userID, username string
+			team             string
+			c                domain.ReviewCounts
+		)
+		err := rows.Scan(&isTeam, &userID, &username, &team,
+			&c.Assignments, &c.ReassignedAway, &c.ReassignedIn, &c.MergedReviewed, &c.OpenLoad)
+		if err != nil {
+			return domain.ReviewerStats{}, err
+		}
+
+		if isTeam {
+			result.Teams = append(result.Teams, domain.TeamReviewStats{TeamName: team, ReviewCounts: c})
+		} else {
+			result.Users = append(result.Users, domain.UserReviewStats{UserID: userID, Username: username, ReviewCounts: c})
+		}
+	}
+	return result, rows.Err()
+}
+
+// percentilesQuery takes the team's pull requests whose %[1]s falls into the
+// window [$2, $3) and returns their count and the p50/p90/p99 of %[1]s - created_at in seconds.
+const percentilesQuery = `
+	SELECT COUNT(*),
+	       percentile_cont(ARRAY[0.5, 0.9, 0.99]) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM %[1]s - created_at)::float8)
+	FROM pull_requests
+	WHERE team_name = $1 AND %[1]s IS NOT NULL
+	  AND ($2::timestamptz IS NULL OR %[1]s >= $2)
+	  AND ($3::timestamptz IS NULL OR %[1]s < $3)`
+
+// TeamDeliveryStats returns time to merge, time to first decision, reassignment
+// rate and weekly throughput of the pull requests owned by the team. Percentiles
+// cannot be combined from daily rollups, so they are read from the team's pull
+// requests through the per-team indexes; everything else comes from the rollups.
+func (r *StatsRepo) TeamDeliveryStats(ctx context.Context, teamName string, window domain.StatsWindow) (domain.TeamDeliveryStats, error) {
+	result := domain.TeamDeliveryStats{TeamName: teamName, Throughput: []domain.WeeklyThroughput{}}
+
+	var err error
+	result.TimeToMerge, err = r.durationPercentiles(ctx, "merged_at", teamName, window)
+	if err != nil {
+		return domain.TeamDeliveryStats{}, err
+	}
+	result.TimeToFirstDecision, err = r.durationPercentiles(ctx, "first_decision_at", teamName, window)
+	if err != nil {
+		return domain.TeamDeliveryStats{}, err
+	}
+
+	err = r.db.QueryRow(ctx, `
+		SELECT COALESCE(SUM(assignments), 0), COALESCE(SUM(reassigned_in), 0)
+		FROM review_stats_user_daily
+		WHERE team_name = $1
+		  AND ($2::timestamptz IS NULL OR day >= ($2::timestamptz AT TIME ZONE 'UTC')::date)
+		  AND ($3::timestamptz IS NULL OR day < ($3::timestamptz AT TIME ZONE 'UTC')::date)`,
+		teamName, window.From, window.To).Scan(&result.Assignments, &result.Reassignments)
+	if err != nil {
+		return domain.TeamDeliveryStats{}, err
+	}
+	if result.Assignments > 0 {
+		result.ReassignmentRate = float64(result.Reassignments) / float64(result.Assignments)
+	}
+
+	// Every week of the window is reported, weeks without merges included. An
+	// open start begins with the team's first merge, an open end with today.
+	rows, err := r.db.Query(ctx, `
+		WITH bounds AS (
+			SELECT COALESCE(($2::timestamptz AT TIME ZONE 'UTC')::date, MIN(day)) AS first_day,
+			       COALESCE(($3::timestamptz AT TIME ZONE 'UTC')::date - 1, (NOW() AT TIME ZONE 'UTC')::date) AS last_day
+			FROM review_stats_team_daily
+			WHERE team_name = $1 AND prs_merged > 0
+		),
+		weeks AS (
+			SELECT generate_series(date_trunc('week', first_day::timestamp), date_trunc('week', last_day::timestamp), INTERVAL '1 week')::date AS week
+			FROM bounds
+			WHERE first_day <= last_day
+		)
+		SELECT w.week, COALESCE(SUM(d.prs_merged), 0)
+		FROM weeks w
+		LEFT JOIN review_stats_team_daily d
+		  ON d.team_name = $1 AND d.day >= w.week AND d.day < w.week + 7
+		  AND ($2::timestamptz IS NULL OR d.day >= ($2::timestamptz AT TIME ZONE 'UTC')::date)
+		  AND ($3::timestamptz IS NULL OR d.day < ($3::timestamptz AT TIME ZONE 'UTC')::date)
+		GROUP BY w.week
+		ORDER BY w.week`, teamName, window.From, window.To)
+	if err != nil {
+		return domain.TeamDeliveryStats{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var w domain.WeeklyThroughput
+		if err := rows.Scan(&w.WeekStart, &w.Merged); err != nil {
+			return domain.TeamDeliveryStats{}, err
+		}
+		result.Throughput = append(result.Throughput, w)
+	}
+	return result, rows.Err()
+}
+
+// durationPercentiles runs percentilesQuery for the given pull_requests
+// timestamp column.
+func (r *StatsRepo) durationPercentiles(ctx context.Context, column, teamName string, window domain.StatsWindow) (domain.DurationPercentiles, error) {
+	var (
+		p       domain.DurationPercentiles
+		seconds []float64
+	)
+	err := r.db.QueryRow(ctx, fmt.Sprintf(percentilesQuery, column), teamName, window.From, window.To).Scan(&p.Count, &seconds)
+	if err != nil || len(seconds) != 3 {
+		return p, err
+	}
+
+	toDuration := func(s float64) time.Duration { return time.Duration(s * float64(time.Second)) }
+	p.P50, p.P90, p.P99 = toDuration(seconds[0]), toDuration(seconds[1]), toDuration(seconds[2])
+	return p, nil
+}
+
+// OpenReviewLoad counts reviewer slots on OPEN pull requests per reviewer.
+func (r *StatsRepo) OpenReviewLoad(ctx context.Context) (map[string]int, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT rev.reviewer_id, COUNT(*)
+		FROM pr_reviewers rev
+		JOIN pull_requests pr ON pr.id = rev.pull_request_id
+		WHERE pr.status = 'OPEN'
+		GROUP BY rev.reviewer_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string]int)
+	for rows.Next() {
+		var (
+			userID string
+			n      int
+		)
+		if err := rows.Scan(&userID, &n); err != nil {
+			return nil, err
+		}
+		result[userID] = n
+	}
+	return result, rows.Err()
+}
+
+// memberLoadsQuery lists members of unarchived teams ($3 unless empty) with the
+// reviews they were assigned on their team's pull requests in the days of
+// [$1, $2), taken from the rollups, and the seconds of the window they were
+// unavailable: absent or deactivated, with overlapping periods merged.
+const memberLoadsQuery = `
+	WITH assigned AS (
+		SELECT team_name, user_id AS reviewer_id, SUM(assignments) AS n
+		FROM review_stats_user_daily
+		WHERE day >= ($1::timestamptz AT TIME ZONE 'UTC')::date
+		  AND day < ($2::timestamptz AT TIME ZONE 'UTC')::date
+		  AND ($3 = '' OR team_name = $3)
+		GROUP BY team_name, user_id
+	),
+	unavailable AS (
+		SELECT user_id, starts_at, ends_at
+		FROM user_absences
+		WHERE starts_at < $2 AND ends_at > $1
+		UNION ALL
+		SELECT user_id, starts_at, COALESCE(ends_at, $2)
+		FROM user_inactivity
+		WHERE starts_at < $2 AND (ends_at IS NULL OR ends_at > $1)
+	),
+	periods AS (
+		SELECT user_id, unnest(range_agg(tstzrange(GREATEST(starts_at, $1), LEAST(ends_at, $2)))) AS period
+		FROM unavailable
+		GROUP BY user_id
+	),
+	absent AS (
+		SELECT user_id, SUM(EXTRACT(EPOCH FROM upper(period) - lower(period)))::float8 AS seconds
+		FROM periods
+		GROUP BY user_id
+	)
+	SELECT tm.team_name, tm.user_id, u.is_active, COALESCE(a.n, 0), COALESCE(ab.seconds, 0)
+	FROM team_members tm
+	JOIN teams t ON t.name = tm.team_name
+	JOIN users u ON u.id = tm.user_id
+	LEFT JOIN assigned a ON a.team_name = tm.team_name AND a.reviewer_id = tm.user_id
+	LEFT JOIN absent ab ON ab.user_id = tm.user_id
+	WHERE t.archived_at IS NULL AND ($3 = '' OR tm.team_name = $3)
+	ORDER BY tm.team_name, tm.user_id`
+
+// MemberLoads returns the review load of every member per team within [from, to).
+func (r *StatsRepo) MemberLoads(ctx context.Context, teamName string, from, to time.Time) (map[string][]domain.MemberLoad, error) {
+	rows, err := r.db.Query(ctx, memberLoadsQuery, from, to, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	window := to.Sub(from).Seconds()
+	result := make(map[string][]domain.MemberLoad)
+	for rows.Next() {
+		var (
+			team          string
+			m             domain.MemberLoad
+			absentSeconds float64
+		)
+		if err := rows.Scan(&team, &m.UserID, &m.IsActive, &m.Assignments, &absentSeconds); err != nil {
+			return nil, err
+		}
+		m.AvailableShare = max(0, 1-absentSeconds/window)
+		result[team] = append(result[team], m)
+	}
+	return result, rows.Err()
+}
@@ -2,7 +2,12 @@ package postgres
 
 import (
 	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -17,56 +22,114 @@ func NewTeamRepo(db *pgxpool.Pool) *TeamRepo {
 	return &TeamRepo{db: db}
 }
 
-func (r *TeamRepo) CreateTeamWithMembers(ctx context.Context, team domain.Team) error {
-	return withTx(ctx, r.db, func(tx pgx.Tx) error {
-		_, err := tx.Exec(ctx, "INSERT INTO teams (name) VALUES ($1)", team.Name)
+// CreateTeamWithMembers creates the team and its members. Members that already
+// exist belong to another team; depending on the policy they fail the request,
+// are moved into the new team, or are skipped and returned.
+func (r *TeamRepo) CreateTeamWithMembers(ctx context.Context, team domain.Team, policy domain.ConflictPolicy) ([]domain.MemberConflict, error) {
+	var skipped []domain.MemberConflict
+
+	ids := make([]string, len(team.Members))
+	usernames := make([]string, len(team.Members))
+	active := make([]bool, len(team.Members))
+	roles := make([]string, len(team.Members))
+	for i, m := range team.Members {
+		ids[i], usernames[i], active[i] = m.ID, m.Username, m.IsActive
+		roles[i] = string(m.Role)
+		if m.Role == "" {
+			roles[i] = string(domain.RoleMember)
+		}
+	}
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, "INSERT INTO teams (name, parent_name) VALUES ($1, $2)", team.Name, team.ParentName)
 		if err != nil {
 			if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
 				return domain.ErrTeamExists
 			}
+			if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23503" {
+				return domain.ErrNotFound
+			}
 			return err
 		}
 
-		batch := &pgx.Batch{}
-		query := `
-			INSERT INTO users (id, username, team_name, is_active) 
-			VALUES ($1, $2, $3, $4)
-			ON CONFLICT (id) DO UPDATE 
-			SET username = EXCLUDED.username, 
-			    team_name = EXCLUDED.team_name, 
-			    is_active = EXCLUDED.is_active`
-
-		for _, m := range team.Members {
-			batch.Queue(query, m.ID, m.Username, team.Name, m.IsActive)
+		onConflict := "DO NOTHING"
+		if policy == domain.ConflictPolicyMove {
+			// Moved users leave their previous primary team.
+			_, err = tx.Exec(ctx, `
+				DELETE FROM team_members tm
+				USING users u
+				WHERE u.id = tm.user_id AND tm.team_name = u.team_name AND u.id = ANY($1)`, ids)
+			if err != nil {
+				return err
+			}
+			onConflict = `DO UPDATE
+				SET username = EXCLUDED.username,
+				    team_name = EXCLUDED.team_name,
+				    is_active = EXCLUDED.is_active`
 		}
 
-		br := tx.SendBatch(ctx, batch)
-		defer br.Close()
+		// The team is brand new, so every user that is not inserted or moved here
+		// already belongs to another team.
+		rows, err := tx.Query(ctx, `
+			INSERT INTO users (id, username, team_name, is_active)
+			SELECT m.id, m.username, $1, m.is_active
+			FROM unnest($2::text[], $3::text[], $4::boolean[]) AS m(id, username, is_active)
+			ON CONFLICT (id) `+onConflict+`
+			RETURNING id`, team.Name, ids, usernames, active)
+		if err != nil {
+			return err
+		}
+		added, err := pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
 
-		for range team.Members {
-			if _, err := br.Exec(); err != nil {
+		if len(added) < len(ids) {
+			rows, err := tx.Query(ctx, `
+				SELECT id, team_name FROM users
+				WHERE id = ANY($1) AND NOT id = ANY($2)
+				ORDER BY id`, ids, added)
+			if err != nil {
 				return err
 			}
+			skipped, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.MemberConflict, error) {
+				c := domain.MemberConflict{Reason: domain.ConflictOtherTeam}
+				return c, row.Scan(&c.UserID, &c.TeamName)
+			})
+			if err != nil {
+				return err
+			}
+			if policy != domain.ConflictPolicySkip {
+				return &domain.MemberConflictError{Conflicts: skipped}
+			}
 		}
 
-		return nil
+		_, err = tx.Exec(ctx, `
+			INSERT INTO team_members (team_name, user_id, role)
+			SELECT $1, m.id, m.role
+			FROM unnest($2::text[], $3::text[]) AS m(id, role)
+			WHERE m.id = ANY($4)`, team.Name, ids, roles, added)
+		return err
 	})
+
+	if err != nil {
+		return nil, err
+	}
+	return skipped, nil
 }
 
 func (r *TeamRepo) GetTeamByName(ctx context.Context, name string) (domain.Team, error) {
-	var team domain.Team
-	team.Name = name
-
-	var exists bool
-	err := r.db.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM teams WHERE name = $1)", name).Scan(&exists)
+	team, err := r.GetTeamInfo(ctx, name)
 	if err != nil {
 		return domain.Team{}, err
 	}
-	if !exists {
-		return domain.Team{}, domain.ErrNotFound
-	}
 
-	rows, err := r.db.Query(ctx, "SELECT id, username, team_name, is_active FROM users WHERE team_name = $1", name)
+	rows, err := r.db.Query(ctx, `
+		SELECT `+memberColumns+`
+		FROM team_members tm
+		JOIN users u ON u.id = tm.user_id
+		WHERE tm.team_name = $1
+		ORDER BY tm.joined_at, u.id`, name)
 	if err != nil {
 		return domain.Team{}, err
 	}
@@ -74,7 +137,7 @@ func (r *TeamRepo) GetTeamByName(ctx context.Context, name string) (domain.Team,
 
 	for rows.Next() {
 		var u domain.User
-		if err := rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive); err != nil {
+		if err := rows.Scan(userDest(&u)...); err != nil {
 			return domain.Team{}, err
 		}
 		team.Members = append(team.Members, u)
@@ -86,3 +149,460 @@ func (r *TeamRepo) GetTeamByName(ctx context.Context, name string) (domain.Team,
 
 	return team, nil
 }
+
+func (r *TeamRepo) UpdateSettings(ctx context.Context, name string, update domain.TeamSettingsUpdate) (domain.Team, error) {
+	inherit := make(map[string]bool, len(update.Inherit))
+	for _, setting := range update.Inherit {
+		inherit[setting] = true
+	}
+
+	set := update.Set
+	ct, err := r.db.Exec(ctx, `
+		UPDATE teams
+		SET reassign_on_deactivate = CASE WHEN $2 THEN NULL ELSE COALESCE($3, reassign_on_deactivate) END,
+		    reviewer_selection = CASE WHEN $4 THEN NULL ELSE COALESCE($5, reviewer_selection) END,
+		    review_sla_hours = CASE WHEN $6 THEN NULL ELSE COALESCE($7, review_sla_hours) END,
+		    reviewer_count = CASE WHEN $8 THEN NULL ELSE COALESCE($9, reviewer_count) END,
+		    escalate_to_parent = CASE WHEN $10 THEN NULL ELSE COALESCE($11, escalate_to_parent) END,
+		    no_candidate_policy = CASE WHEN $12 THEN NULL ELSE COALESCE($13, no_candidate_policy) END,
+		    merge_policy = CASE WHEN $14 THEN NULL ELSE COALESCE($15, merge_policy) END
+		WHERE name = $1`,
+		name,
+		inherit[domain.SettingReassignOnDeactivate], set.ReassignOnDeactivate,
+		inherit[domain.SettingReviewerSelection], set.ReviewerSelection,
+		inherit[domain.SettingReviewSLAHours], set.ReviewSLAHours,
+		inherit[domain.SettingReviewerCount], set.ReviewerCount,
+		inherit[domain.SettingEscalateToParent], set.EscalateToParent,
+		inherit[domain.SettingNoCandidatePolicy], set.NoCandidatePolicy,
+		inherit[domain.SettingMergePolicy], set.MergePolicy,
+	)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.Team{}, domain.ErrNotFound
+	}
+
+	return r.GetTeamInfo(ctx, name)
+}
+
+// IsLead reports whether the user is an active lead of the team or, if
+// inherited is set, of one of its ancestors.
+func (r *TeamRepo) IsLead(ctx context.Context, teamName, userID string, inherited bool) (bool, error) {
+	var isLead bool
+	err := r.db.QueryRow(ctx, `
+		WITH RECURSIVE ancestors AS (
+			SELECT name, parent_name FROM teams WHERE name = $1
+			UNION
+			SELECT t.name, t.parent_name
+			FROM teams t
+			JOIN ancestors a ON t.name = a.parent_name
+			WHERE $3
+		)
+		SELECT EXISTS(
+			SELECT 1 FROM team_members tm
+			JOIN ancestors a ON a.name = tm.team_name
+			JOIN users u ON u.id = tm.user_id
+			WHERE tm.user_id = $2 AND tm.role = 'LEAD' AND u.is_active
+		)`, teamName, userID, inherited).Scan(&isLead)
+	return isLead, err
+}
+
+func (r *TeamRepo) SetMemberRole(ctx context.Context, teamName, userID string, role domain.TeamRole) (domain.User, error) {
+	var u domain.User
+	err := r.db.QueryRow(ctx, `
+		WITH tm AS (
+			UPDATE team_members SET role = $3
+			WHERE team_name = $1 AND user_id = $2
+			RETURNING user_id, role
+		)
+		SELECT `+memberColumns+`
+		FROM tm
+		JOIN users u ON u.id = tm.user_id`, teamName, userID, role).
+		Scan(userDest(&u)...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.User{}, domain.ErrNotFound
+		}
+		return domain.User{}, err
+	}
+	return u, nil
+}
+
+// AddMember adds an existing user to the team in addition to their other teams.
+// Adding a current member only changes their role.
+func (r *TeamRepo) AddMember(ctx context.Context, teamName, userID string, role domain.TeamRole) (domain.User, error) {
+	var u domain.User
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var archived bool
+		err := tx.QueryRow(ctx, "SELECT archived_at IS NOT NULL FROM teams WHERE name = $1", teamName).Scan(&archived)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+		if archived {
+			return domain.ErrTeamArchived
+		}
+
+		err = tx.QueryRow(ctx, `
+			WITH tm AS (
+				INSERT INTO team_members (team_name, user_id, role)
+				VALUES ($1, $2, $3)
+				ON CONFLICT (team_name, user_id) DO UPDATE SET role = EXCLUDED.role
+				RETURNING user_id, role
+			)
+			SELECT `+memberColumns+`
+			FROM tm
+			JOIN users u ON u.id = tm.user_id`, teamName, userID, role).
+			Scan(userDest(&u)...)
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23503" {
+			return domain.ErrNotFound
+		}
+		return err
+	})
+	return u, err
+}
+
+// RemoveMember removes the user from a secondary team. Their open reviews on
+// the team's pull requests are handed over to the remaining members.
+// The primary team can only be changed by a transfer.
+func (r *TeamRepo) RemoveMember(ctx context.Context, teamName, userID string) ([]domain.Reassignment, error) {
+	var reassigned []domain.Reassignment
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var primary string
+		err := tx.QueryRow(ctx, `
+			SELECT u.team_name
+			FROM team_members tm
+			JOIN users u ON u.id = tm.user_id
+			WHERE tm.team_name = $1 AND tm.user_id = $2
+			FOR UPDATE OF tm`, teamName, userID).Scan(&primary)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+		if primary == teamName {
+			return fmt.Errorf("%w: cannot leave the primary team, transfer the user instead", domain.ErrInvalidInput)
+		}
+
+		if _, err := tx.Exec(ctx, "DELETE FROM team_members WHERE team_name = $1 AND user_id = $2", teamName, userID); err != nil {
+			return err
+		}
+
+		reassigned, err = reassignOpenReviews(ctx, tx, []string{userID}, teamName)
+		if err != nil {
+			return err
+		}
+		return insertOutbox(ctx, tx, events.ReviewsMoved(domain.ReassignMembership, reassigned)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reassigned, nil
+}
+
+func (r *TeamRepo) DeactivateMembers(ctx context.Context, teamName string, userIDs []string, allExcept bool) ([]string, []domain.Reassignment, error) {
+	var deactivated []string
+	var reassigned []domain.Reassignment
+
+	if userIDs == nil {
+		// A nil slice is sent as NULL, which would turn "<> ALL" into NULL.
+		userIDs = []string{}
+	}
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var exists bool
+		err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM teams WHERE name = $1)", teamName).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return domain.ErrNotFound
+		}
+
+		// Users who were inactive already are reported as deactivated, but only
+		// the others get a user.deactivated event.
+		rows, err := tx.Query(ctx, `
+			UPDATE users u SET is_active = false
+			FROM (
+				SELECT id, is_active FROM users
+				WHERE id IN (SELECT user_id FROM team_members WHERE team_name = $1)
+				  AND (($3 AND id <> ALL($2)) OR (NOT $3 AND id = ANY($2)))
+				FOR UPDATE
+			) old
+			WHERE u.id = old.id
+			RETURNING u.id, old.is_active`, teamName, userIDs, allExcept)
+		if err != nil {
+			return err
+		}
+		var newlyDeactivated []string
+		var id string
+		var wasActive bool
+		_, err = pgx.ForEachRow(rows, []any{&id, &wasActive}, func() error {
+			deactivated = append(deactivated, id)
+			if wasActive {
+				newlyDeactivated = append(newlyDeactivated, id)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if !allExcept && len(deactivated) != countDistinct(userIDs) {
+			return domain.ErrNotFound
+		}
+		if len(deactivated) == 0 {
+			return nil
+		}
+
+		// Deactivation is global, so reviews in the users' other teams are handed over too.
+		reassigned, err = reassignOpenReviews(ctx, tx, deactivated, "")
+		if err != nil {
+			return err
+		}
+		return insertOutbox(ctx, tx, append(events.UsersDeactivated(newlyDeactivated...),
+			events.ReviewsMoved(domain.ReassignDeactivation, reassigned)...)...)
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+	return deactivated, reassigned, nil
+}
+
+func countDistinct(ids []string) int {
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		seen[id] = struct{}{}
+	}
+	return len(seen)
+}
+
+// Archive marks the team as archived and deactivates the members whose primary
+// team it is; members who only joined it as a secondary team stay active.
+// Their open reviews are handed over in the same transaction. Archiving an
+// already archived team keeps the original timestamp.
+func (r *TeamRepo) Archive(ctx context.Context, name string) (time.Time, []string, []domain.Reassignment, error) {
+	var archivedAt time.Time
+	var deactivated []string
+	var reassigned []domain.Reassignment
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		err := tx.QueryRow(ctx, `
+			UPDATE teams SET archived_at = COALESCE(archived_at, NOW())
+			WHERE name = $1
+			RETURNING archived_at`, name).Scan(&archivedAt)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+
+		rows, err := tx.Query(ctx, `
+			UPDATE users SET is_active = false
+			WHERE team_name = $1 AND is_active
+			RETURNING id`, name)
+		if err != nil {
+			return err
+		}
+		deactivated, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return err
+		}
+		reassigned, err = reassignOpenReviews(ctx, tx, deactivated, "")
+		if err != nil {
+			return err
+		}
+		return insertOutbox(ctx, tx, append(events.UsersDeactivated(deactivated...),
+			events.ReviewsMoved(domain.ReassignDeactivation, reassigned)...)...)
+	})
+
+	if err != nil {
+		return time.Time{}, nil, nil, err
+	}
+	return archivedAt, deactivated, reassigned, nil
+}
+
+// Delete deletes a team without members, sub-teams or open pull requests. Its
+// merged and closed pull requests keep the team's name.
+func (r *TeamRepo) Delete(ctx context.Context, name string) error {
+	return withTx(ctx, r.db, func(tx pgx.Tx) error {
+		ct, err := tx.Exec(ctx, "SELECT 1 FROM teams WHERE name = $1 FOR UPDATE", name)
+		if err != nil {
+			return err
+		}
+		if ct.RowsAffected() == 0 {
+			return domain.ErrNotFound
+		}
+
+		var hasMembers, hasChildren, hasOpenPRs bool
+		err = tx.QueryRow(ctx, `
+			SELECT EXISTS(SELECT 1 FROM team_members WHERE team_name = $1),
+			       EXISTS(SELECT 1 FROM teams WHERE parent_name = $1),
+			       EXISTS(SELECT 1 FROM pull_requests WHERE team_name = $1 AND status = 'OPEN')`,
+			name).Scan(&hasMembers, &hasChildren, &hasOpenPRs)
+		if err != nil {
+			return err
+		}
+		if hasMembers {
+			return domain.ErrTeamNotEmpty
+		}
+		if hasChildren {
+			return fmt.Errorf("%w: team still has sub-teams", domain.ErrTeamNotEmpty)
+		}
+		if hasOpenPRs {
+			return fmt.Errorf("%w: team still has open pull requests", domain.ErrTeamNotEmpty)
+		}
+
+		_, err = tx.Exec(ctx, "DELETE FROM teams WHERE name = $1", name)
+		return err
+	})
+}
+
+// lockTeam keeps the team from being deleted or renamed until the transaction
+// ends, so that an open pull request is never left with a deleted team.
+func lockTeam(ctx context.Context, tx pgx.Tx, name string) error {
+	ct, err := tx.Exec(ctx, "SELECT 1 FROM teams WHERE name = $1 FOR SHARE", name)
+	if err != nil {
+		return err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Rename changes the team's name. Foreign keys cascade the new name to members,
+// sub-teams and aliases, and the team's pull requests are moved to it here; the
+// old name becomes an alias of the team.
+func (r *TeamRepo) Rename(ctx context.Context, name, newName string, aliasExpiresAt time.Time) error {
+	return withTx(ctx, r.db, func(tx pgx.Tx) error {
+		ct, err := tx.Exec(ctx, "SELECT 1 FROM teams WHERE name = $1 FOR UPDATE", name)
+		if err != nil {
+			return err
+		}
+		if ct.RowsAffected() == 0 {
+			return domain.ErrNotFound
+		}
+
+		// The new name stops being an alias of whatever team it pointed to.
+		_, err = tx.Exec(ctx, "DELETE FROM team_aliases WHERE alias = $1 OR expires_at <= NOW()", newName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, "UPDATE teams SET name = $2 WHERE name = $1", name, newName)
+		if err != nil {
+			if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+				return domain.ErrTeamExists
+			}
+			return err
+		}
+
+		_, err = tx.Exec(ctx, "UPDATE pull_requests SET team_name = $2 WHERE team_name = $1", name, newName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO team_aliases (alias, team_name, expires_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (alias) DO UPDATE
+			SET team_name = EXCLUDED.team_name, expires_at = EXCLUDED.expires_at`, name, newName, aliasExpiresAt)
+		return err
+	})
+}
+
+// ResolveName returns the current name of the team known by name, following an
+// unexpired alias when no team has that name.
+func (r *TeamRepo) ResolveName(ctx context.Context, name string) (string, error) {
+	var resolved string
+	err := r.db.QueryRow(ctx, `
+		SELECT COALESCE(
+			(SELECT t.name FROM teams t WHERE t.name = $1),
+			(SELECT a.team_name FROM team_aliases a WHERE a.alias = $1 AND a.expires_at > NOW()),
+			$1)`, name).Scan(&resolved)
+	return resolved, err
+}
+
+var teamSortColumns = map[string]sortColumn{
+	"name":     {expr: "name", typ: "text"},
+	"members":  {expr: "member_count", typ: "bigint"},
+	"open_prs": {expr: "open_pr_count", typ: "bigint"},
+}
+
+func (r *TeamRepo) List(ctx context.Context, filter domain.TeamFilter, page domain.PageRequest) ([]domain.TeamSummary, string, error) {
+	if page.SortBy == "" {
+		page.SortBy = "name"
+	}
+	col, ok := teamSortColumns[page.SortBy]
+	if !ok {
+		return nil, "", fmt.Errorf("%w: unknown sort field %q", domain.ErrInvalidInput, page.SortBy)
+	}
+	limit := pageLimit(page)
+
+	cond, order, args, err := keyset(col, "name", page, []any{filter.IncludeArchived})
+	if err != nil {
+		return nil, "", err
+	}
+	args = append(args, limit+1)
+
+	query := fmt.Sprintf(`
+		WITH stats AS (
+			SELECT t.name, t.archived_at,
+			       COUNT(u.id) AS member_count,
+			       COUNT(u.id) FILTER (WHERE u.is_active) AS active_count,
+			       (SELECT COUNT(*)
+			        FROM pull_requests pr
+			        WHERE pr.team_name = t.name AND pr.status = 'OPEN') AS open_pr_count
+			FROM teams t
+			LEFT JOIN team_members tm ON tm.team_name = t.name
+			LEFT JOIN users u ON u.id = tm.user_id
+			WHERE $1 OR t.archived_at IS NULL
+			GROUP BY t.name
+		)
+		SELECT name, archived_at, member_count, active_count, open_pr_count
+		FROM stats
+		WHERE %s
+		%s
+		LIMIT $%d`, cond, order, len(args))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var teams []domain.TeamSummary
+	for rows.Next() {
+		var t domain.TeamSummary
+		if err := rows.Scan(&t.Name, &t.ArchivedAt, &t.MemberCount, &t.ActiveCount, &t.OpenPRCount); err != nil {
+			return nil, "", err
+		}
+		teams = append(teams, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(teams) <= limit {
+		return teams, "", nil
+	}
+	teams = teams[:limit]
+
+	last := teams[limit-1]
+	value := last.Name
+	switch page.SortBy {
+	case "members":
+		value = strconv.Itoa(last.MemberCount)
+	case "open_prs":
+		value = strconv.Itoa(last.OpenPRCount)
+	}
+	next := encodeCursor(cursor{SortBy: page.SortBy, Desc: page.Desc, Value: value, Key: last.Name})
+	return teams, next, nil
+}
@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// teamColumns selects a teams row aliased as "t" in the order expected by teamDest.
+const teamColumns = `t.name, t.parent_name, t.archived_at,
+	t.reassign_on_deactivate, t.reviewer_selection, t.review_sla_hours, t.reviewer_count, t.escalate_to_parent,
+	t.no_candidate_policy, t.merge_policy`
+
+func teamDest(t *domain.Team) []any {
+	o := &t.Overrides
+	return []any{&t.Name, &t.ParentName, &t.ArchivedAt,
+		&o.ReassignOnDeactivate, &o.ReviewerSelection, &o.ReviewSLAHours, &o.ReviewerCount, &o.EscalateToParent,
+		&o.NoCandidatePolicy, &o.MergePolicy}
+}
+
+// maxTeamDepth bounds ancestor walks in case the hierarchy is ever corrupted.
+const maxTeamDepth = 32
+
+// GetTeamInfo returns the team with its effective settings but without members.
+func (r *TeamRepo) GetTeamInfo(ctx context.Context, name string) (domain.Team, error) {
+	return getTeamInfo(ctx, r.db, name)
+}
+
+func getTeamInfo(ctx context.Context, q querier, name string) (domain.Team, error) {
+	rows, err := q.Query(ctx, `
+		WITH RECURSIVE chain AS (
+			SELECT `+teamColumns+`, 0 AS depth
+			FROM teams t
+			WHERE t.name = $1
+			UNION ALL
+			SELECT `+teamColumns+`, chain.depth + 1
+			FROM teams t
+			JOIN chain ON t.name = chain.parent_name
+			WHERE chain.depth < $2
+		)
+		SELECT t.* FROM chain t ORDER BY t.depth`, name, maxTeamDepth)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	defer rows.Close()
+
+	var chain []domain.Team
+	for rows.Next() {
+		var t domain.Team
+		var depth int
+		if err := rows.Scan(append(teamDest(&t), &depth)...); err != nil {
+			return domain.Team{}, err
+		}
+		chain = append(chain, t)
+	}
+	if err := rows.Err(); err != nil {
+		return domain.Team{}, err
+	}
+	if len(chain) == 0 {
+		return domain.Team{}, domain.ErrNotFound
+	}
+
+	overrides := make([]domain.TeamSettingsOverrides, len(chain))
+	for i, t := range chain {
+		overrides[i] = t.Overrides
+	}
+
+	team := chain[0]
+	team.Settings = domain.ResolveSettings(overrides)
+	return team, nil
+}
+
+// SetParent moves the team under parent, or makes it a root team when parent is nil.
+func (r *TeamRepo) SetParent(ctx context.Context, name string, parent *string) error {
+	return withTx(ctx, r.db, func(tx pgx.Tx) error {
+		// Serialize hierarchy changes so that two concurrent moves cannot form a cycle.
+		if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('teams.parent_name'))"); err != nil {
+			return err
+		}
+
+		if parent != nil {
+			var found, cycle bool
+			err := tx.QueryRow(ctx, `
+				WITH RECURSIVE ancestors AS (
+					SELECT name, parent_name FROM teams WHERE name = $1
+					UNION
+					SELECT t.name, t.parent_name
+					FROM teams t
+					JOIN ancestors a ON t.name = a.parent_name
+				)
+				SELECT EXISTS(SELECT 1 FROM ancestors),
+				       EXISTS(SELECT 1 FROM ancestors WHERE name = $2)`, *parent, name).Scan(&found, &cycle)
+			if err != nil {
+				return err
+			}
+			if !found {
+				return domain.ErrNotFound
+			}
+			if cycle {
+				return fmt.Errorf("%w: team %q cannot be placed under its own sub-team", domain.ErrInvalidInput, name)
+			}
+		}
+
+		ct, err := tx.Exec(ctx, "UPDATE teams SET parent_name = $2 WHERE name = $1", name, parent)
+		if err != nil {
+			return err
+		}
+		if ct.RowsAffected() == 0 {
+			return domain.ErrNotFound
+		}
+		return nil
+	})
+}
+
+// GetTree returns the hierarchy below root, or the whole forest when root is nil.
+// Archived teams and their sub-teams are skipped unless includeArchived is set.
+func (r *TeamRepo) GetTree(ctx context.Context, root *string, includeArchived bool) ([]domain.TeamNode, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT `+teamColumns+`, (SELECT COUNT(*) FROM team_members tm WHERE tm.team_name = t.name)
+		FROM teams t
+		ORDER BY t.name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	nodes := make(map[string]*domain.TeamNode)
+	var order []string
+	for rows.Next() {
+		var n domain.TeamNode
+		if err := rows.Scan(append(teamDest(&n.Team), &n.MemberCount)...); err != nil {
+			return nil, err
+		}
+		nodes[n.Name] = &n
+		order = append(order, n.Name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	children := make(map[string][]string)
+	var roots []string
+	for _, name := range order {
+		n := nodes[name]
+		n.Settings = domain.ResolveSettings(ancestorOverrides(nodes, name))
+		if n.ParentName == nil {
+			roots = append(roots, name)
+		} else {
+			children[*n.ParentName] = append(children[*n.ParentName], name)
+		}
+	}
+
+	if root != nil {
+		if _, ok := nodes[*root]; !ok {
+			return nil, domain.ErrNotFound
+		}
+		roots = []string{*root}
+	}
+
+	var build func(name string, depth int) (domain.TeamNode, bool)
+	build = func(name string, depth int) (domain.TeamNode, bool) {
+		n := *nodes[name]
+		if (n.ArchivedAt != nil && !includeArchived) || depth > maxTeamDepth {
+			return domain.TeamNode{}, false
+		}
+		sort.Strings(children[name])
+		for _, child := range children[name] {
+			if c, ok := build(child, depth+1); ok {
+				n.Children = append(n.Children, c)
+			}
+		}
+		return n, true
+	}
+
+	tree := make([]domain.TeamNode, 0, len(roots))
+	for _, name := range roots {
+		if n, ok := build(name, 0); ok {
+			tree = append(tree, n)
+		}
+	}
+	if root != nil && len(tree) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return tree, nil
+}
+
+func ancestorOverrides(nodes map[string]*domain.TeamNode, name string) []domain.TeamSettingsOverrides {
+	var chain []domain.TeamSettingsOverrides
+	for depth := 0; depth <= maxTeamDepth; depth++ {
+		n, ok := nodes[name]
+		if !ok {
+			break
+		}
+		chain = append(chain, n.Overrides)
+		if n.ParentName == nil {
+			break
+		}
+		name = *n.ParentName
+	}
+	return chain
+}
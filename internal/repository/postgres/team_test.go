@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTeamRepoDeleteKeepsHistory(t *testing.T) {
+	pool := testPool(t)
+	ctx := context.Background()
+
+	suffix := uuid.NewString()[:8]
+	home, team, author := "home-"+suffix, "team-"+suffix, "author-"+suffix
+	_, err := pool.Exec(ctx, `
+		WITH t AS (INSERT INTO teams (name) VALUES ($1), ($2)),
+		u AS (INSERT INTO users (id, username, team_name) VALUES ($3, $3, $1))
+		INSERT INTO team_members (team_name, user_id) VALUES ($1, $3)`,
+		home, team, author)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prs, teams := NewPRRepo(pool), NewTeamRepo(pool)
+	pr := domain.PullRequest{
+		ID:        "pr-" + suffix,
+		Name:      "Delete test",
+		AuthorID:  author,
+		TeamName:  team,
+		Status:    domain.PRStatusOpen,
+		CreatedAt: time.Now(),
+	}
+	if err := prs.Create(ctx, pr); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := teams.Delete(ctx, team); !errors.Is(err, domain.ErrTeamNotEmpty) {
+		t.Fatalf("Delete with an open pull request = %v, want %v", err, domain.ErrTeamNotEmpty)
+	}
+
+	if _, _, err := prs.SetClosed(ctx, pr.ID, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := teams.Delete(ctx, team); err != nil {
+		t.Fatalf("Delete with a closed pull request: %v", err)
+	}
+
+	got, err := prs.GetByID(ctx, pr.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.TeamName != team {
+		t.Errorf("TeamName = %q, want %q", got.TeamName, team)
+	}
+	if _, _, err := prs.SetClosed(ctx, pr.ID, false); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("reopen after the team was deleted = %v, want %v", err, domain.ErrNotFound)
+	}
+}
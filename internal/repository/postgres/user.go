@@ -2,10 +2,14 @@ package postgres
 
 import (
 	"avito-test-task/internal/domain"
+	"avito-test-task/internal/events"
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -17,11 +21,71 @@ func NewUserRepo(db *pgxpool.Pool) *UserRepo {
 	return &UserRepo{db: db}
 }
 
-func (r *UserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) (domain.User, error) {
+func (r *UserRepo) SetIsActive(ctx context.Context, userID string, isActive, reassign bool) (domain.User, []domain.Reassignment, error) {
 	var u domain.User
-	query := `UPDATE users SET is_active = $1 WHERE id = $2 RETURNING id, username, team_name, is_active`
+	var reassigned []domain.Reassignment
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var wasActive bool
+		err := tx.QueryRow(ctx, "SELECT is_active FROM users WHERE id = $1 FOR UPDATE", userID).Scan(&wasActive)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+
+		query := `UPDATE users u SET is_active = $1 WHERE u.id = $2 RETURNING ` + userColumns
+		if err := tx.QueryRow(ctx, query, isActive, userID).Scan(userDest(&u)...); err != nil {
+			return err
+		}
+
+		if isActive {
+			return nil
+		}
+
+		var evs []domain.Event
+		if wasActive {
+			evs = events.UsersDeactivated(u.ID)
+		}
+		if reassign {
+			reassigned, err = reassignOpenReviews(ctx, tx, []string{u.ID}, "")
+			if err != nil {
+				return err
+			}
+		}
+		return insertOutbox(ctx, tx, append(evs, events.ReviewsMoved(domain.ReassignDeactivation, reassigned)...)...)
+	})
+
+	if err != nil {
+		return domain.User{}, nil, err
+	}
+	return u, reassigned, nil
+}
+
+// userColumns selects a users row aliased as "u" in the order expected by userDest.
+// The role is the one the user holds in their primary team.
+const userColumns = `u.id, u.username, u.team_name,
+	COALESCE((SELECT pm.role FROM team_members pm WHERE pm.user_id = u.id AND pm.team_name = u.team_name), 'MEMBER'),
+	` + userTailColumns
+
+// memberColumns is like userColumns, but takes the role from a team_members row
+// aliased as "tm", for queries that list the members of a particular team.
+const memberColumns = "u.id, u.username, u.team_name, tm.role, " + userTailColumns
+
+const userTailColumns = "u.is_active, u.timezone, u.work_start_minute, u.work_end_minute"
+
+func userDest(u *domain.User) []any {
+	return []any{&u.ID, &u.Username, &u.TeamName, &u.Role, &u.IsActive,
+		&u.WorkingHours.Timezone, &u.WorkingHours.Start, &u.WorkingHours.End}
+}
+
+func (r *UserRepo) GetByID(ctx context.Context, userID string) (domain.User, error) {
+	var u domain.User
+	err := r.db.QueryRow(ctx,
+		"SELECT "+userColumns+" FROM users u WHERE u.id = $1", userID).
+		Scan(userDest(&u)...)
 
-	err := r.db.QueryRow(ctx, query, isActive, userID).Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.User{}, domain.ErrNotFound
@@ -31,24 +95,102 @@ func (r *UserRepo) SetIsActive(ctx context.Context, userID string, isActive bool
 	return u, nil
 }
 
-func (r *UserRepo) GetByID(ctx context.Context, userID string) (domain.User, error) {
+func (r *UserRepo) GetProfile(ctx context.Context, userID string) (domain.UserProfile, error) {
+	var p domain.UserProfile
+	err := r.db.QueryRow(ctx, `
+		SELECT `+userColumns+`,
+		       (SELECT COUNT(*)
+		        FROM pr_reviewers rev
+		        JOIN pull_requests pr ON pr.id = rev.pull_request_id
+		        WHERE rev.reviewer_id = u.id AND pr.status = 'OPEN'),
+		       (SELECT COUNT(*)
+		        FROM pull_requests pr
+		        WHERE pr.author_id = u.id AND pr.status = 'OPEN')
+		FROM users u
+		WHERE u.id = $1`, userID).
+		Scan(append(userDest(&p.User), &p.OpenReviewCount, &p.OpenAuthoredCount)...)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.UserProfile{}, domain.ErrNotFound
+		}
+		return domain.UserProfile{}, err
+	}
+
+	p.Teams, err = r.GetMemberships(ctx, userID)
+	if err != nil {
+		return domain.UserProfile{}, err
+	}
+	return p, nil
+}
+
+// GetMemberships lists all teams of the user, the primary team first.
+func (r *UserRepo) GetMemberships(ctx context.Context, userID string) ([]domain.Membership, error) {
+	rows, err := r.db.Query(ctx, `
+		SELECT tm.team_name, tm.role, tm.team_name = u.team_name
+		FROM team_members tm
+		JOIN users u ON u.id = tm.user_id
+		WHERE tm.user_id = $1
+		ORDER BY tm.team_name = u.team_name DESC, tm.team_name`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []domain.Membership
+	for rows.Next() {
+		var m domain.Membership
+		if err := rows.Scan(&m.TeamName, &m.Role, &m.Primary); err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+	return result, rows.Err()
+}
+
+func (r *UserRepo) Update(ctx context.Context, userID string, update domain.UserUpdate) (domain.User, error) {
 	var u domain.User
-	err := r.db.QueryRow(ctx,
-		"SELECT id, username, team_name, is_active FROM users WHERE id = $1", userID).
-		Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive)
+	err := r.db.QueryRow(ctx, `
+		UPDATE users u
+		SET username = COALESCE($2, username),
+		    timezone = COALESCE($3, timezone),
+		    work_start_minute = COALESCE($4, work_start_minute),
+		    work_end_minute = COALESCE($5, work_end_minute)
+		WHERE id = $1
+		RETURNING `+userColumns,
+		userID, update.Username, update.Timezone, update.WorkStart, update.WorkEnd).
+		Scan(userDest(&u)...)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.User{}, domain.ErrNotFound
 		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23514" {
+			return domain.User{}, fmt.Errorf("%w: working hours must start before they end within one day", domain.ErrInvalidInput)
+		}
 		return domain.User{}, err
 	}
 	return u, nil
 }
 
-func (r *UserRepo) GetActiveUsersByTeam(ctx context.Context, teamName string) ([]domain.User, error) {
-	rows, err := r.db.Query(ctx,
-		"SELECT id, username, team_name, is_active FROM users WHERE team_name = $1 AND is_active = true", teamName)
+// userAvailable is a condition on a users row aliased as "u" that holds when the
+// user can be picked as a reviewer right now: active and not on a scheduled absence.
+const userAvailable = `u.is_active AND NOT EXISTS (
+	SELECT 1 FROM user_absences ab
+	WHERE ab.user_id = u.id AND ab.starts_at <= NOW() AND ab.ends_at > NOW()
+)`
+
+func (r *UserRepo) GetAvailableUsersByTeam(ctx context.Context, teamName string) ([]domain.User, error) {
+	return getAvailableUsersByTeam(ctx, r.db, teamName)
+}
+
+func getAvailableUsersByTeam(ctx context.Context, q querier, teamName string) ([]domain.User, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+memberColumns+`
+		FROM team_members tm
+		JOIN users u ON u.id = tm.user_id
+		WHERE tm.team_name = $1 AND `+userAvailable, teamName)
 	if err != nil {
 		return nil, err
 	}
@@ -57,10 +199,232 @@ func (r *UserRepo) GetActiveUsersByTeam(ctx context.Context, teamName string) ([
 	var users []domain.User
 	for rows.Next() {
 		var u domain.User
-		if err := rows.Scan(&u.ID, &u.Username, &u.TeamName, &u.IsActive); err != nil {
+		if err := rows.Scan(userDest(&u)...); err != nil {
 			return nil, err
 		}
 		users = append(users, u)
 	}
 	return users, rows.Err()
 }
+
+// GetEscalationContacts returns, for each of the teams, the available leads and
+// maintainers of the team and of its ancestors up to maxDepth levels above it.
+// They are ordered nearest team first and leads before maintainers.
+func (r *UserRepo) GetEscalationContacts(ctx context.Context, teamNames []string, maxDepth int) (map[string][]domain.User, error) {
+	rows, err := r.db.Query(ctx, `
+		WITH RECURSIVE chain AS (
+			SELECT name AS origin, name, parent_name, 0 AS depth FROM teams WHERE name = ANY($1)
+			UNION ALL
+			SELECT c.origin, t.name, t.parent_name, c.depth + 1
+			FROM teams t
+			JOIN chain c ON t.name = c.parent_name
+			WHERE c.depth < $2
+		)
+		SELECT c.origin, `+memberColumns+`
+		FROM chain c
+		JOIN team_members tm ON tm.team_name = c.name
+		JOIN users u ON u.id = tm.user_id
+		WHERE tm.role IN ('LEAD', 'MAINTAINER') AND `+userAvailable+`
+		ORDER BY c.origin, c.depth, tm.role = 'MAINTAINER', u.id`, teamNames, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contacts := make(map[string][]domain.User)
+	for rows.Next() {
+		var origin string
+		var u domain.User
+		if err := rows.Scan(append([]any{&origin}, userDest(&u)...)...); err != nil {
+			return nil, err
+		}
+		contacts[origin] = append(contacts[origin], u)
+	}
+	return contacts, rows.Err()
+}
+
+// GetAvailableUsersInSubtree returns available members of the team and of all
+// of its non-archived sub-teams.
+func (r *UserRepo) GetAvailableUsersInSubtree(ctx context.Context, teamName string) ([]domain.User, error) {
+	return getAvailableUsersInSubtree(ctx, r.db, teamName)
+}
+
+func getAvailableUsersInSubtree(ctx context.Context, q querier, teamName string) ([]domain.User, error) {
+	rows, err := q.Query(ctx, `
+		WITH RECURSIVE subtree AS (
+			SELECT name FROM teams WHERE name = $1
+			UNION
+			SELECT t.name
+			FROM teams t
+			JOIN subtree s ON t.parent_name = s.name
+			WHERE t.archived_at IS NULL
+		)
+		SELECT DISTINCT ON (u.id) `+memberColumns+`
+		FROM subtree s
+		JOIN team_members tm ON tm.team_name = s.name
+		JOIN users u ON u.id = tm.user_id
+		WHERE `+userAvailable+`
+		ORDER BY u.id, tm.team_name = $1 DESC`, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []domain.User
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(userDest(&u)...); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+func (r *UserRepo) TransferToTeam(ctx context.Context, userID, teamName string, policy domain.ReviewPolicy) (domain.User, []domain.Reassignment, error) {
+	var u domain.User
+	var reassigned []domain.Reassignment
+
+	err := withTx(ctx, r.db, func(tx pgx.Tx) error {
+		var oldTeam string
+		err := tx.QueryRow(ctx, "SELECT team_name FROM users WHERE id = $1 FOR UPDATE", userID).Scan(&oldTeam)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+
+		var archived bool
+		err = tx.QueryRow(ctx, "SELECT archived_at IS NOT NULL FROM teams WHERE name = $1", teamName).Scan(&archived)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+		if archived {
+			return domain.ErrTeamArchived
+		}
+
+		if oldTeam != teamName {
+			switch policy {
+			case domain.ReviewPolicyFail:
+				n, err := countOpenReviews(ctx, tx, userID, oldTeam)
+				if err != nil {
+					return err
+				}
+				if n > 0 {
+					return domain.ErrHasOpenReviews
+				}
+			case domain.ReviewPolicyReassign:
+				reassigned, err = reassignOpenReviews(ctx, tx, []string{userID}, oldTeam)
+				if err != nil {
+					return err
+				}
+				for _, ra := range reassigned {
+					if ra.Outcome != domain.OutcomeReplaced {
+						return domain.ErrNoCandidate
+					}
+				}
+			}
+		}
+
+		// The primary membership moves to the new team; other memberships are kept.
+		if oldTeam != teamName {
+			_, err = tx.Exec(ctx, "DELETE FROM team_members WHERE team_name = $1 AND user_id = $2", oldTeam, userID)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(ctx, `
+				INSERT INTO team_members (team_name, user_id) VALUES ($1, $2)
+				ON CONFLICT DO NOTHING`, teamName, userID)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.QueryRow(ctx, `
+			UPDATE users u SET team_name = $1
+			WHERE u.id = $2
+			RETURNING `+userColumns, teamName, userID).
+			Scan(userDest(&u)...)
+		if err != nil {
+			return err
+		}
+
+		return insertOutbox(ctx, tx, events.ReviewsMoved(domain.ReassignMembership, reassigned)...)
+	})
+
+	if err != nil {
+		return domain.User{}, nil, err
+	}
+	return u, reassigned, nil
+}
+
+var userSortColumns = map[string]sortColumn{
+	"user_id":  {expr: "id", typ: "text"},
+	"username": {expr: "username", typ: "text"},
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *UserRepo) List(ctx context.Context, filter domain.UserFilter, page domain.PageRequest) ([]domain.User, string, error) {
+	if page.SortBy == "" {
+		page.SortBy = "user_id"
+	}
+	col, ok := userSortColumns[page.SortBy]
+	if !ok {
+		return nil, "", fmt.Errorf("%w: unknown sort field %q", domain.ErrInvalidInput, page.SortBy)
+	}
+	limit := pageLimit(page)
+
+	args := []any{filter.TeamName, filter.IsActive, likeEscaper.Replace(filter.Search)}
+	cond, order, args, err := keyset(col, "id", page, args)
+	if err != nil {
+		return nil, "", err
+	}
+	args = append(args, limit+1)
+
+	query := fmt.Sprintf(`
+		SELECT `+userColumns+`
+		FROM users u
+		WHERE ($1::text IS NULL OR EXISTS(
+			SELECT 1 FROM team_members m WHERE m.user_id = u.id AND m.team_name = $1))
+		  AND ($2::boolean IS NULL OR is_active = $2)
+		  AND ($3 = '' OR username ILIKE '%%' || $3 || '%%')
+		  AND %s
+		%s
+		LIMIT $%d`, cond, order, len(args))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var users []domain.User
+	for rows.Next() {
+		var u domain.User
+		if err := rows.Scan(userDest(&u)...); err != nil {
+			return nil, "", err
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	if len(users) <= limit {
+		return users, "", nil
+	}
+	users = users[:limit]
+
+	last := users[limit-1]
+	value := last.ID
+	if page.SortBy == "username" {
+		value = last.Username
+	}
+	next := encodeCursor(cursor{SortBy: page.SortBy, Desc: page.Desc, Value: value, Key: last.ID})
+	return users, next, nil
+}
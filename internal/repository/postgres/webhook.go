@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WebhookRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewWebhookRepo(db *pgxpool.Pool) *WebhookRepo {
+	return &WebhookRepo{db: db}
+}
+
+const subscriptionColumns = "id, url, secret, events, created_at"
+
+func scanSubscription(row pgx.Row) (domain.WebhookSubscription, error) {
+	var s domain.WebhookSubscription
+	var events []string
+	if err := row.Scan(&s.ID, &s.URL, &s.Secret, &events, &s.CreatedAt); err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+	s.Events = make([]domain.EventType, len(events))
+	for i, e := range events {
+		s.Events[i] = domain.EventType(e)
+	}
+	return s, nil
+}
+
+const deliveryColumns = `id, subscription_id, event_id, event, payload, status, attempts, next_attempt_at,
+	last_attempt_at, response_status, last_error, redelivery_of, created_at, delivered_at`
+
+func deliveryDest(d *domain.WebhookDelivery) []any {
+	return []any{&d.ID, &d.SubscriptionID, &d.EventID, &d.Event, &d.Payload, &d.Status, &d.Attempts, &d.NextAttemptAt,
+		&d.LastAttemptAt, &d.ResponseStatus, &d.LastError, &d.RedeliveryOf, &d.CreatedAt, &d.DeliveredAt}
+}
+
+func (r *WebhookRepo) CreateSubscription(ctx context.Context, sub domain.WebhookSubscription) (domain.WebhookSubscription, error) {
+	events := make([]string, len(sub.Events))
+	for i, e := range sub.Events {
+		events[i] = string(e)
+	}
+	return scanSubscription(r.db.QueryRow(ctx, `
+		INSERT INTO webhook_subscriptions (url, secret, events) VALUES ($1, $2, $3)
+		RETURNING `+subscriptionColumns,
+		sub.URL, sub.Secret, events))
+}
+
+func (r *WebhookRepo) ListSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error) {
+	rows, err := r.db.Query(ctx, "SELECT "+subscriptionColumns+" FROM webhook_subscriptions ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.WebhookSubscription, error) {
+		return scanSubscription(row)
+	})
+}
+
+// DeleteSubscription deletes the subscription together with its delivery log.
+func (r *WebhookRepo) DeleteSubscription(ctx context.Context, id int64) error {
+	ct, err := r.db.Exec(ctx, "DELETE FROM webhook_subscriptions WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Enqueue schedules a delivery of each event to every subscription that asked
+// for its type and returns how many were scheduled. Deliveries are numbered in
+// the order of the events, which ClaimDue keeps per event key. An event that
+// was already enqueued is skipped, so it can be enqueued again after a failure.
+func (r *WebhookRepo) Enqueue(ctx context.Context, events []domain.Event) (int, error) {
+	ids := make([]string, len(events))
+	types := make([]string, len(events))
+	keys := make([]string, len(events))
+	payloads := make([][]byte, len(events))
+	for i, e := range events {
+		ids[i], types[i], keys[i], payloads[i] = e.ID, string(e.Type), e.Key, e.Payload
+	}
+
+	ct, err := r.db.Exec(ctx, `
+		INSERT INTO webhook_deliveries (subscription_id, event_id, event, event_key, payload, next_attempt_at)
+		SELECT s.id, e.id, e.event, e.key, e.payload, NOW()
+		FROM unnest($1::text[], $2::text[], $3::text[], $4::bytea[]) WITH ORDINALITY AS e(id, event, key, payload, n)
+		JOIN webhook_subscriptions s ON e.event = ANY(s.events)
+		ORDER BY e.n, s.id
+		ON CONFLICT (subscription_id, event_id) WHERE redelivery_of IS NULL DO NOTHING`,
+		ids, types, keys, payloads)
+	if err != nil {
+		return 0, err
+	}
+	return int(ct.RowsAffected()), nil
+}
+
+// ClaimDue picks up to limit pending deliveries that are due and postpones
+// them by lease, so that other instances skip them while they are being sent
+// and a delivery whose sender died is retried once the lease runs out. Only
+// the oldest pending delivery of an event key is claimed for a subscription:
+// later events of the pull request or user wait until it succeeds or runs out
+// of attempts, so they reach the subscriber in order.
+func (r *WebhookRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]domain.DueDelivery, error) {
+	rows, err := r.db.Query(ctx, `
+		WITH due AS (
+			SELECT d.id FROM webhook_deliveries d
+			WHERE d.status = 'PENDING' AND d.next_attempt_at <= NOW()
+			  AND NOT EXISTS (
+				SELECT 1 FROM webhook_deliveries p
+				WHERE p.subscription_id = d.subscription_id AND p.event_key = d.event_key
+				  AND p.status = 'PENDING' AND p.id < d.id)
+			ORDER BY d.next_attempt_at, d.id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE webhook_deliveries d
+		SET next_attempt_at = NOW() + make_interval(secs => $2)
+		FROM due, webhook_subscriptions s
+		WHERE d.id = due.id AND s.id = d.subscription_id
+		RETURNING d.id, d.subscription_id, d.event_id, d.event, d.payload, d.status, d.attempts, d.next_attempt_at,
+			d.last_attempt_at, d.response_status, d.last_error, d.redelivery_of, d.created_at, d.delivered_at,
+			s.url, s.secret`,
+		limit, lease.Seconds())
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.DueDelivery, error) {
+		var d domain.DueDelivery
+		err := row.Scan(append(deliveryDest(&d.Delivery), &d.URL, &d.Secret)...)
+		return d, err
+	})
+}
+
+// RecordAttempt stores the outcome of sending a claimed delivery.
+func (r *WebhookRepo) RecordAttempt(ctx context.Context, id int64, attempt domain.DeliveryAttempt) error {
+	_, err := r.db.Exec(ctx, `
+		UPDATE webhook_deliveries SET
+			attempts = attempts + 1,
+			last_attempt_at = $2,
+			response_status = $3,
+			last_error = NULLIF($4, ''),
+			status = CASE WHEN $5 THEN 'SUCCEEDED' WHEN $6::timestamptz IS NULL THEN 'FAILED' ELSE 'PENDING' END,
+			next_attempt_at = CASE WHEN $5 THEN NULL ELSE $6::timestamptz END,
+			delivered_at = CASE WHEN $5 THEN $2 END
+		WHERE id = $1`,
+		id, attempt.At, attempt.ResponseStatus, attempt.Error, attempt.Succeeded, attempt.NextAttemptAt)
+	return err
+}
+
+// ListDeliveries pages through the delivery log, newest first.
+func (r *WebhookRepo) ListDeliveries(ctx context.Context, filter domain.DeliveryFilter, page domain.PageRequest) ([]domain.WebhookDelivery, string, error) {
+	page.SortBy, page.Desc = "id", true
+	limit := pageLimit(page)
+
+	cur, err := decodeCursor(page.Cursor, page)
+	if err != nil {
+		return nil, "", err
+	}
+	var before *int64
+	if cur != nil {
+		id, err := strconv.ParseInt(cur.Key, 10, 64)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+		}
+		before = &id
+	}
+
+	rows, err := r.db.Query(ctx, `
+		SELECT `+deliveryColumns+`
+		FROM webhook_deliveries
+		WHERE ($1::bigint IS NULL OR subscription_id = $1)
+		  AND ($2::text IS NULL OR status = $2)
+		  AND ($3::bigint IS NULL OR id < $3)
+		ORDER BY id DESC
+		LIMIT $4`,
+		filter.SubscriptionID, filter.Status, before, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	deliveries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.WebhookDelivery, error) {
+		var d domain.WebhookDelivery
+		err := row.Scan(deliveryDest(&d)...)
+		return d, err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(deliveries) <= limit {
+		return deliveries, "", nil
+	}
+	deliveries = deliveries[:limit]
+
+	key := strconv.FormatInt(deliveries[limit-1].ID, 10)
+	next := encodeCursor(cursor{SortBy: page.SortBy, Desc: page.Desc, Value: key, Key: key})
+	return deliveries, next, nil
+}
+
+// Redeliver schedules a new delivery with the payload of an earlier one, due
+// at once. The earlier delivery is left as it is in the log.
+func (r *WebhookRepo) Redeliver(ctx context.Context, id int64) (domain.WebhookDelivery, error) {
+	var d domain.WebhookDelivery
+	err := r.db.QueryRow(ctx, `
+		INSERT INTO webhook_deliveries (subscription_id, event_id, event, event_key, payload, next_attempt_at, redelivery_of)
+		SELECT subscription_id, event_id, event, event_key, payload, NOW(), id FROM webhook_deliveries WHERE id = $1
+		RETURNING `+deliveryColumns, id).Scan(deliveryDest(&d)...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.WebhookDelivery{}, domain.ErrNotFound
+	}
+	return d, err
+}
@@ -3,26 +3,100 @@ package repository
 import (
 	"avito-test-task/internal/domain"
 	"context"
+	"time"
 )
 
 type TeamRepository interface {
-	CreateTeamWithMembers(ctx context.Context, team domain.Team) error
+	CreateTeamWithMembers(ctx context.Context, team domain.Team, policy domain.ConflictPolicy) ([]domain.MemberConflict, error)
 	GetTeamByName(ctx context.Context, name string) (domain.Team, error)
+	List(ctx context.Context, filter domain.TeamFilter, page domain.PageRequest) ([]domain.TeamSummary, string, error)
+	GetTeamInfo(ctx context.Context, name string) (domain.Team, error)
+	UpdateSettings(ctx context.Context, name string, update domain.TeamSettingsUpdate) (domain.Team, error)
+	SetParent(ctx context.Context, name string, parent *string) error
+	Rename(ctx context.Context, name, newName string, aliasExpiresAt time.Time) error
+	ResolveName(ctx context.Context, name string) (string, error)
+	IsLead(ctx context.Context, teamName, userID string, inherited bool) (bool, error)
+	SetMemberRole(ctx context.Context, teamName, userID string, role domain.TeamRole) (domain.User, error)
+	AddMember(ctx context.Context, teamName, userID string, role domain.TeamRole) (domain.User, error)
+	RemoveMember(ctx context.Context, teamName, userID string) ([]domain.Reassignment, error)
+	GetTree(ctx context.Context, root *string, includeArchived bool) ([]domain.TeamNode, error)
+	DeactivateMembers(ctx context.Context, teamName string, userIDs []string, allExcept bool) ([]string, []domain.Reassignment, error)
+	Archive(ctx context.Context, name string) (time.Time, []string, []domain.Reassignment, error)
+	Delete(ctx context.Context, name string) error
 }
 
 type UserRepository interface {
-	SetIsActive(ctx context.Context, userID string, isActive bool) (domain.User, error)
+	SetIsActive(ctx context.Context, userID string, isActive, reassign bool) (domain.User, []domain.Reassignment, error)
 	GetByID(ctx context.Context, userID string) (domain.User, error)
-	GetActiveUsersByTeam(ctx context.Context, teamName string) ([]domain.User, error)
+	GetProfile(ctx context.Context, userID string) (domain.UserProfile, error)
+	Update(ctx context.Context, userID string, update domain.UserUpdate) (domain.User, error)
+	GetAvailableUsersByTeam(ctx context.Context, teamName string) ([]domain.User, error)
+	GetAvailableUsersInSubtree(ctx context.Context, teamName string) ([]domain.User, error)
+	GetEscalationContacts(ctx context.Context, teamNames []string, maxDepth int) (map[string][]domain.User, error)
+	GetMemberships(ctx context.Context, userID string) ([]domain.Membership, error)
+	List(ctx context.Context, filter domain.UserFilter, page domain.PageRequest) ([]domain.User, string, error)
+	TransferToTeam(ctx context.Context, userID, teamName string, policy domain.ReviewPolicy) (domain.User, []domain.Reassignment, error)
+}
+
+type AbsenceRepository interface {
+	Create(ctx context.Context, a domain.Absence) (domain.Absence, []domain.Reassignment, error)
+	GetByID(ctx context.Context, id int64) (domain.Absence, error)
+	ListByUser(ctx context.Context, userID string, includePast bool) ([]domain.Absence, error)
+	Update(ctx context.Context, id int64, update domain.AbsenceUpdate) (domain.Absence, []domain.Reassignment, error)
+	Delete(ctx context.Context, id int64) error
+	ReassignStarted(ctx context.Context) ([]domain.Reassignment, error)
 }
 
 type PullRequestRepository interface {
 	Create(ctx context.Context, pr domain.PullRequest) error
 	GetByID(ctx context.Context, id string) (domain.PullRequest, error)
 
-	Merge(ctx context.Context, id string) (domain.PullRequest, error)
+	Merge(ctx context.Context, id string, policy domain.MergePolicy) (domain.PullRequest, bool, error)
+	SetClosed(ctx context.Context, id string, closed bool) (domain.PullRequest, bool, error)
+	UpdateName(ctx context.Context, id, name string) error
 
 	UpdateReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string) error
+	RemoveReviewer(ctx context.Context, prID, reviewerID string, park bool) error
+	RecordDecision(ctx context.Context, prID, reviewerID string, decision domain.ReviewDecision) error
 
 	GetByReviewerID(ctx context.Context, reviewerID string) ([]domain.PullRequest, error)
+	GetAssignments(ctx context.Context, prID string) ([]domain.ReviewAssignment, error)
+	ListOpenReviews(ctx context.Context) ([]domain.OpenReview, error)
+	Escalate(ctx context.Context, escalations []domain.Escalation) ([]domain.Escalation, error)
+	ListOpen(ctx context.Context, teamName string) ([]domain.PullRequest, error)
+	Backfill(ctx context.Context, prID string, candidateIDs []string, target int) ([]string, error)
+}
+
+type StatsRepository interface {
+	ReviewerStats(ctx context.Context, teamName string, window domain.StatsWindow) (domain.ReviewerStats, error)
+	TeamDeliveryStats(ctx context.Context, teamName string, window domain.StatsWindow) (domain.TeamDeliveryStats, error)
+	OpenReviewLoad(ctx context.Context) (map[string]int, error)
+	MemberLoads(ctx context.Context, teamName string, from, to time.Time) (map[string][]domain.MemberLoad, error)
+	RefreshRollups(ctx context.Context, now time.Time) (int, error)
+	RebuildRollups(ctx context.Context, from, to time.Time) error
+	Export(ctx context.Context, req domain.ExportRequest) (domain.ExportPage, error)
+}
+
+type IntegrationRepository interface {
+	SetUser(ctx context.Context, provider domain.Provider, login, userID string) error
+	SetTeam(ctx context.Context, provider domain.Provider, externalTeam, teamName string) error
+	ListUsers(ctx context.Context, provider domain.Provider) ([]domain.ExternalUser, error)
+	ListTeams(ctx context.Context, provider domain.Provider) ([]domain.ExternalTeam, error)
+	ResolveUser(ctx context.Context, provider domain.Provider, login string) (string, error)
+	ResolveTeams(ctx context.Context, provider domain.Provider, externalTeams []string) ([]string, error)
+}
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, sub domain.WebhookSubscription) (domain.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]domain.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+	Enqueue(ctx context.Context, events []domain.Event) (int, error)
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]domain.DueDelivery, error)
+	RecordAttempt(ctx context.Context, id int64, attempt domain.DeliveryAttempt) error
+	ListDeliveries(ctx context.Context, filter domain.DeliveryFilter, page domain.PageRequest) ([]domain.WebhookDelivery, string, error)
+	Redeliver(ctx context.Context, id int64) (domain.WebhookDelivery, error)
+}
+
+type OutboxRepository interface {
+	Relay(ctx context.Context, limit int, publish func(context.Context, []domain.Event) ([]string, error)) (int, error)
 }
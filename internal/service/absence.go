@@ -0,0 +1,79 @@
+package service
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"fmt"
+)
+
+// CreateAbsence records an absence of the user. Users manage their own
+// absences; leads of their team and the admin manage anyone's.
+func (s *service) CreateAbsence(ctx context.Context, actor domain.Actor, a domain.Absence) (domain.Absence, []domain.Reassignment, error) {
+	if !a.EndsAt.After(a.StartsAt) {
+		return domain.Absence{}, nil, fmt.Errorf("%w: absence must end after it starts", domain.ErrInvalidInput)
+	}
+	if err := s.requireAbsenceAccess(ctx, actor, a.UserID); err != nil {
+		return domain.Absence{}, nil, err
+	}
+
+	created, reassigned, err := s.absenceRepo.Create(ctx, a)
+	if err != nil {
+		return domain.Absence{}, nil, err
+	}
+	s.recordReassigned(domain.ReassignAbsence, reassigned)
+	return created, reassigned, nil
+}
+
+func (s *service) ListAbsences(ctx context.Context, userID string, includePast bool) ([]domain.Absence, error) {
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.absenceRepo.ListByUser(ctx, userID, includePast)
+}
+
+func (s *service) UpdateAbsence(ctx context.Context, actor domain.Actor, id int64, update domain.AbsenceUpdate) (domain.Absence, []domain.Reassignment, error) {
+	absence, err := s.absenceRepo.GetByID(ctx, id)
+	if err != nil {
+		return domain.Absence{}, nil, err
+	}
+	if err := s.requireAbsenceAccess(ctx, actor, absence.UserID); err != nil {
+		return domain.Absence{}, nil, err
+	}
+
+	updated, reassigned, err := s.absenceRepo.Update(ctx, id, update)
+	if err != nil {
+		return domain.Absence{}, nil, err
+	}
+	s.recordReassigned(domain.ReassignAbsence, reassigned)
+	return updated, reassigned, nil
+}
+
+func (s *service) DeleteAbsence(ctx context.Context, actor domain.Actor, id int64) error {
+	absence, err := s.absenceRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.requireAbsenceAccess(ctx, actor, absence.UserID); err != nil {
+		return err
+	}
+	return s.absenceRepo.Delete(ctx, id)
+}
+
+// requireAbsenceAccess checks the actor may manage the user's absences, the
+// same way as the user's active flag.
+func (s *service) requireAbsenceAccess(ctx context.Context, actor domain.Actor, userID string) error {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.requireSelfOrLead(ctx, actor, user)
+}
+
+// ReassignStartedAbsences hands over open reviews of users whose absence has just
+// begun. It is meant to be called periodically.
+func (s *service) ReassignStartedAbsences(ctx context.Context) ([]domain.Reassignment, error) {
+	reassigned, err := s.absenceRepo.ReassignStarted(ctx)
+	s.recordReassigned(domain.ReassignAbsence, reassigned)
+	return reassigned, err
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"avito-test-task/internal/auth"
+	"avito-test-task/internal/domain"
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultActorTokenTTL is how long an actor token lasts unless asked otherwise.
+	DefaultActorTokenTTL = 12 * time.Hour
+	// MaxActorTokenTTL bounds the lifetime of an actor token.
+	MaxActorTokenTTL = 30 * 24 * time.Hour
+)
+
+// IssueActorToken returns a token that identifies the user in X-Actor-Token
+// until it expires. Only the admin issues tokens; a zero ttl means
+// DefaultActorTokenTTL.
+func (s *service) IssueActorToken(ctx context.Context, actor domain.Actor, userID string, ttl time.Duration) (string, time.Time, error) {
+	if err := requireAdmin(actor); err != nil {
+		return "", time.Time{}, err
+	}
+	if s.cfg.ActorTokenSecret == "" {
+		return "", time.Time{}, fmt.Errorf("%w: actor tokens are not configured", domain.ErrInvalidInput)
+	}
+	if ttl == 0 {
+		ttl = DefaultActorTokenTTL
+	}
+	if ttl < 0 || ttl > MaxActorTokenTTL {
+		return "", time.Time{}, fmt.Errorf("%w: token lifetime must be positive and at most %s", domain.ErrInvalidInput, MaxActorTokenTTL)
+	}
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().Add(ttl).Truncate(time.Second)
+	return auth.Sign(s.cfg.ActorTokenSecret, userID, expiresAt), expiresAt, nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"log"
+	"time"
+)
+
+// backfillTimeout bounds the backfill that runs inline with a request after a
+// team gains an available member.
+const backfillTimeout = 2 * time.Second
+
+// BackfillReviewers tops up OPEN pull requests of every team; see backfill. It
+// is meant to be called periodically.
+func (s *service) BackfillReviewers(ctx context.Context) ([]domain.Backfill, error) {
+	return s.backfill(ctx, "")
+}
+
+// BackfillTeamReviewers tops up OPEN pull requests of a single team on behalf
+// of one of its leads.
+func (s *service) BackfillTeamReviewers(ctx context.Context, actor domain.Actor, teamName string) ([]domain.Backfill, error) {
+	teamName, err := s.resolveTeamName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireLead(ctx, actor, teamName); err != nil {
+		return nil, err
+	}
+	return s.backfill(ctx, teamName)
+}
+
+// backfill tops up OPEN pull requests of the team that have fewer reviewers
+// than the team's reviewer count, for example because the team was too small
+// when they were created or a slot was parked on reassignment. Slots removed
+// on reassignment are not refilled. An empty name covers every team.
+func (s *service) backfill(ctx context.Context, teamName string) ([]domain.Backfill, error) {
+	teams := make(map[string]domain.Team)
+	if teamName != "" {
+		team, err := s.teamRepo.GetTeamInfo(ctx, teamName)
+		if err != nil {
+			return nil, err
+		}
+		teams[team.Name] = team
+	}
+
+	prs, err := s.prRepo.ListOpen(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var result []domain.Backfill
+	for _, pr := range prs {
+		team, ok := teams[pr.TeamName]
+		if !ok {
+			team, err = s.teamRepo.GetTeamInfo(ctx, pr.TeamName)
+			if err != nil {
+				return result, err
+			}
+			teams[pr.TeamName] = team
+		}
+		target := team.Settings.ReviewerCount - pr.RemovedSlots
+		if team.ArchivedAt != nil || len(pr.Reviewers) >= target {
+			continue
+		}
+
+		exclude := map[string]bool{pr.AuthorID: true}
+		for _, r := range pr.Reviewers {
+			exclude[r] = true
+		}
+		candidates, err := s.reviewerCandidates(ctx, team, exclude)
+		if err != nil {
+			return result, err
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+		domain.OrderCandidates(candidates, team.Settings.ReviewerSelection, now)
+
+		ids := make([]string, len(candidates))
+		for i, c := range candidates {
+			ids[i] = c.ID
+		}
+		added, err := s.prRepo.Backfill(ctx, pr.ID, ids, target)
+		if err != nil {
+			return result, err
+		}
+		if len(added) > 0 {
+			result = append(result, domain.Backfill{PullRequestID: pr.ID, TeamName: pr.TeamName, ReviewerIDs: added})
+		}
+	}
+
+	return result, nil
+}
+
+// backfillTeams runs backfill for teams that have just gained an available
+// member. It is best effort and bounded by backfillTimeout: the change that
+// triggered it is already saved, and whatever is left is picked up by the
+// periodic backfill.
+func (s *service) backfillTeams(ctx context.Context, teamNames ...string) {
+	ctx, cancel := context.WithTimeout(ctx, backfillTimeout)
+	defer cancel()
+
+	for _, name := range teamNames {
+		if _, err := s.backfill(ctx, name); err != nil {
+			log.Printf("Backfill of team %s failed: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// ExportData returns a page of an analytics dataset. With Pseudonymize set,
+// user ids and usernames are replaced by keyed hashes that stay the same across
+// pages and exports, so the datasets can still be joined on them.
+func (s *service) ExportData(ctx context.Context, req domain.ExportRequest) (domain.ExportPage, error) {
+	if !req.Dataset.Valid() {
+		return domain.ExportPage{}, fmt.Errorf("%w: unknown dataset %q", domain.ErrInvalidInput, req.Dataset)
+	}
+	if err := validateWindow(req.Window); err != nil {
+		return domain.ExportPage{}, err
+	}
+	if req.Pseudonymize && len(s.cfg.PseudonymKey) == 0 {
+		return domain.ExportPage{}, fmt.Errorf("%w: pseudonymization is not configured", domain.ErrInvalidInput)
+	}
+	switch {
+	case req.Limit <= 0:
+		req.Limit = domain.DefaultExportPageLimit
+	case req.Limit > domain.MaxExportPageLimit:
+		req.Limit = domain.MaxExportPageLimit
+	}
+
+	page, err := s.statsRepo.Export(ctx, req)
+	if err != nil || !req.Pseudonymize {
+		return page, err
+	}
+
+	columns, rows := page.Columns, page.Rows
+	page.Rows = func(yield func([]any, error) bool) {
+		for row, err := range rows {
+			if err == nil {
+				for i, col := range columns {
+					if v, ok := row[i].(string); ok && col.Personal {
+						row[i] = s.pseudonym(v)
+					}
+				}
+			}
+			if !yield(row, err) {
+				return
+			}
+		}
+	}
+	return page, nil
+}
+
+func (s *service) pseudonym(value string) string {
+	mac := hmac.New(sha256.New, s.cfg.PseudonymKey)
+	mac.Write([]byte(value))
+	return "u_" + hex.EncodeToString(mac.Sum(nil))[:16]
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// SetExternalUser maps a code host login onto a user. An empty user id removes
+// the mapping.
+func (s *service) SetExternalUser(ctx context.Context, actor domain.Actor, provider domain.Provider, login, userID string) error {
+	if err := requireAdmin(actor); err != nil {
+		return err
+	}
+	if !provider.Valid() {
+		return fmt.Errorf("%w: unknown provider %q", domain.ErrInvalidInput, provider)
+	}
+	if login == "" {
+		return fmt.Errorf("%w: login is required", domain.ErrInvalidInput)
+	}
+	return s.integRepo.SetUser(ctx, provider, login, userID)
+}
+
+// SetExternalTeam maps a code host team onto a team. An empty team name removes
+// the mapping.
+func (s *service) SetExternalTeam(ctx context.Context, actor domain.Actor, provider domain.Provider, externalTeam, teamName string) error {
+	if err := requireAdmin(actor); err != nil {
+		return err
+	}
+	if !provider.Valid() {
+		return fmt.Errorf("%w: unknown provider %q", domain.ErrInvalidInput, provider)
+	}
+	if externalTeam == "" {
+		return fmt.Errorf("%w: external team is required", domain.ErrInvalidInput)
+	}
+	if teamName != "" {
+		var err error
+		if teamName, err = s.resolveTeamName(ctx, teamName); err != nil {
+			return err
+		}
+	}
+	return s.integRepo.SetTeam(ctx, provider, externalTeam, teamName)
+}
+
+func (s *service) ListExternalMappings(ctx context.Context, provider domain.Provider) ([]domain.ExternalUser, []domain.ExternalTeam, error) {
+	if !provider.Valid() {
+		return nil, nil, fmt.Errorf("%w: unknown provider %q", domain.ErrInvalidInput, provider)
+	}
+	users, err := s.integRepo.ListUsers(ctx, provider)
+	if err != nil {
+		return nil, nil, err
+	}
+	teams, err := s.integRepo.ListTeams(ctx, provider)
+	if err != nil {
+		return nil, nil, err
+	}
+	return users, teams, nil
+}
+
+// SyncExternalPullRequest applies a pull request change reported by a code host.
+// Code hosts redeliver events, so every action is idempotent: opening a pull
+// request that already exists returns it, and reopening or updating an unknown
+// one creates it.
+func (s *service) SyncExternalPullRequest(ctx context.Context, event domain.ExternalPREvent) (domain.PullRequest, error) {
+	switch event.Action {
+	case domain.ExternalPROpened:
+		return s.createExternalPR(ctx, event)
+	case domain.ExternalPRReopened:
+		pr, err := s.ReopenPR(ctx, event.PullRequestID)
+		if errors.Is(err, domain.ErrNotFound) {
+			return s.createExternalPR(ctx, event)
+		}
+		return pr, err
+	case domain.ExternalPRClosed:
+		return s.ClosePR(ctx, event.PullRequestID)
+	case domain.ExternalPRMerged:
+		// The code host has merged it already; the policy only guards our own merges.
+		return s.mergePR(ctx, event.PullRequestID, domain.MergeAny)
+	case domain.ExternalPRUpdated:
+		pr, err := s.prRepo.GetByID(ctx, event.PullRequestID)
+		if errors.Is(err, domain.ErrNotFound) {
+			return s.createExternalPR(ctx, event)
+		}
+		if err != nil || event.Title == "" || pr.Name == event.Title {
+			return pr, err
+		}
+		if err := s.prRepo.UpdateName(ctx, pr.ID, event.Title); err != nil {
+			return domain.PullRequest{}, err
+		}
+		pr.Name = event.Title
+		return pr, nil
+	}
+	return domain.PullRequest{}, fmt.Errorf("%w: unknown pull request action %q", domain.ErrInvalidInput, event.Action)
+}
+
+// createExternalPR creates the pull request for its mapped author. It belongs to
+// the first mapped team the author is a member of, or else to the author's
+// primary team.
+func (s *service) createExternalPR(ctx context.Context, event domain.ExternalPREvent) (domain.PullRequest, error) {
+	if event.AuthorLogin == "" {
+		return domain.PullRequest{}, fmt.Errorf("%w: %s did not report the author of %q", domain.ErrNotFound, event.Provider, event.PullRequestID)
+	}
+	authorID, err := s.integRepo.ResolveUser(ctx, event.Provider, event.AuthorLogin)
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.PullRequest{}, fmt.Errorf("%w: no user is mapped to %s login %q", domain.ErrNotFound, event.Provider, event.AuthorLogin)
+	}
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	pr := domain.PullRequest{ID: event.PullRequestID, Name: event.Title, AuthorID: authorID}
+	if len(event.Teams) > 0 {
+		teamNames, err := s.integRepo.ResolveTeams(ctx, event.Provider, event.Teams)
+		if err != nil {
+			return domain.PullRequest{}, err
+		}
+		memberships, err := s.userRepo.GetMemberships(ctx, authorID)
+		if err != nil {
+			return domain.PullRequest{}, err
+		}
+		for _, name := range teamNames {
+			if slices.ContainsFunc(memberships, func(m domain.Membership) bool { return m.TeamName == name }) {
+				pr.TeamName = name
+				break
+			}
+		}
+	}
+
+	created, err := s.CreatePR(ctx, pr)
+	if errors.Is(err, domain.ErrPRExists) {
+		return s.prRepo.GetByID(ctx, event.PullRequestID)
+	}
+	return created, err
+}
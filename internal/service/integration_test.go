@@ -0,0 +1,320 @@
+package service
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/repository"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// The fakes embed the repository interfaces, so a test fails loudly with a nil
+// dereference if the service reaches a method it was not meant to call.
+
+type fakeTeamRepo struct {
+	repository.TeamRepository
+	teams map[string]domain.Team
+}
+
+func (r *fakeTeamRepo) ResolveName(_ context.Context, name string) (string, error) {
+	if _, ok := r.teams[name]; !ok {
+		return "", domain.ErrNotFound
+	}
+	return name, nil
+}
+
+func (r *fakeTeamRepo) GetTeamInfo(_ context.Context, name string) (domain.Team, error) {
+	team, ok := r.teams[name]
+	if !ok {
+		return domain.Team{}, domain.ErrNotFound
+	}
+	return team, nil
+}
+
+type fakeUserRepo struct {
+	repository.UserRepository
+	users       map[string]domain.User
+	memberships map[string][]domain.Membership
+}
+
+func (r *fakeUserRepo) GetByID(_ context.Context, id string) (domain.User, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return domain.User{}, domain.ErrNotFound
+	}
+	return u, nil
+}
+
+func (r *fakeUserRepo) GetMemberships(_ context.Context, id string) ([]domain.Membership, error) {
+	return r.memberships[id], nil
+}
+
+func (r *fakeUserRepo) GetAvailableUsersByTeam(_ context.Context, teamName string) ([]domain.User, error) {
+	var users []domain.User
+	for id, ms := range r.memberships {
+		for _, m := range ms {
+			if m.TeamName == teamName && r.users[id].IsActive {
+				users = append(users, r.users[id])
+			}
+		}
+	}
+	return users, nil
+}
+
+type fakePRRepo struct {
+	repository.PullRequestRepository
+	prs map[string]domain.PullRequest
+}
+
+func (r *fakePRRepo) Create(_ context.Context, pr domain.PullRequest) error {
+	if _, ok := r.prs[pr.ID]; ok {
+		return domain.ErrPRExists
+	}
+	r.prs[pr.ID] = pr
+	return nil
+}
+
+func (r *fakePRRepo) GetByID(_ context.Context, id string) (domain.PullRequest, error) {
+	pr, ok := r.prs[id]
+	if !ok {
+		return domain.PullRequest{}, domain.ErrNotFound
+	}
+	return pr, nil
+}
+
+func (r *fakePRRepo) UpdateName(_ context.Context, id, name string) error {
+	pr := r.prs[id]
+	pr.Name = name
+	r.prs[id] = pr
+	return nil
+}
+
+type fakeIntegrationRepo struct {
+	repository.IntegrationRepository
+	users   map[string]string
+	teams   map[string]string
+	changed int
+}
+
+func (r *fakeIntegrationRepo) SetUser(context.Context, domain.Provider, string, string) error {
+	r.changed++
+	return nil
+}
+
+func (r *fakeIntegrationRepo) SetTeam(context.Context, domain.Provider, string, string) error {
+	r.changed++
+	return nil
+}
+
+func (r *fakeIntegrationRepo) ResolveUser(_ context.Context, _ domain.Provider, login string) (string, error) {
+	id, ok := r.users[login]
+	if !ok {
+		return "", domain.ErrNotFound
+	}
+	return id, nil
+}
+
+func (r *fakeIntegrationRepo) ResolveTeams(_ context.Context, _ domain.Provider, externalTeams []string) ([]string, error) {
+	var names []string
+	for _, t := range externalTeams {
+		if name, ok := r.teams[t]; ok {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+type integrationFixture struct {
+	svc   *service
+	teams *fakeTeamRepo
+	prs   *fakePRRepo
+	integ *fakeIntegrationRepo
+}
+
+// newIntegrationFixture sets up alice, whose primary team is backend and who
+// is also a member of payments, with one teammate able to review in each team.
+// GitHub login alice-dev is mapped to her and the payments-backend GitHub team
+// to payments.
+func newIntegrationFixture() integrationFixture {
+	settings := domain.TeamSettings{ReviewerCount: 2, ReviewerSelection: domain.SelectionRandom}
+	teams := &fakeTeamRepo{teams: map[string]domain.Team{
+		"backend":  {Name: "backend", Settings: settings},
+		"payments": {Name: "payments", Settings: settings},
+	}}
+	users := &fakeUserRepo{
+		users: map[string]domain.User{
+			"alice": {ID: "alice", TeamName: "backend", IsActive: true},
+			"bob":   {ID: "bob", TeamName: "backend", IsActive: true},
+			"carol": {ID: "carol", TeamName: "payments", IsActive: true},
+		},
+		memberships: map[string][]domain.Membership{
+			"alice": {{TeamName: "backend", Primary: true}, {TeamName: "payments"}},
+			"bob":   {{TeamName: "backend", Primary: true}},
+			"carol": {{TeamName: "payments", Primary: true}},
+		},
+	}
+	prs := &fakePRRepo{prs: map[string]domain.PullRequest{}}
+	integ := &fakeIntegrationRepo{
+		users: map[string]string{"alice-dev": "alice"},
+		teams: map[string]string{"payments-backend": "payments"},
+	}
+	svc := NewService(teams, users, prs, nil, nil, integ, nil, nil, Config{})
+	return integrationFixture{svc: svc, teams: teams, prs: prs, integ: integ}
+}
+
+func TestSyncExternalPullRequestOpened(t *testing.T) {
+	tests := []struct {
+		name     string
+		teams    []string
+		wantTeam string
+		wantRev  string
+	}{
+		{name: "mapped team the author belongs to", teams: []string{"unmapped", "payments-backend"}, wantTeam: "payments", wantRev: "carol"},
+		{name: "no mapped team", teams: []string{"unmapped"}, wantTeam: "backend", wantRev: "bob"},
+		{name: "no team requested", wantTeam: "backend", wantRev: "bob"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newIntegrationFixture()
+			event := domain.ExternalPREvent{
+				Provider:      domain.ProviderGitHub,
+				Action:        domain.ExternalPROpened,
+				PullRequestID: "acme/payments#42",
+				Title:         "Retry card authorisation",
+				AuthorLogin:   "alice-dev",
+				Teams:         tt.teams,
+			}
+
+			pr, err := f.svc.SyncExternalPullRequest(context.Background(), event)
+			if err != nil {
+				t.Fatalf("SyncExternalPullRequest: %v", err)
+			}
+			if pr.AuthorID != "alice" || pr.TeamName != tt.wantTeam {
+				t.Errorf("pr author/team = %s/%s, want alice/%s", pr.AuthorID, pr.TeamName, tt.wantTeam)
+			}
+			if len(pr.Reviewers) != 1 || pr.Reviewers[0] != tt.wantRev {
+				t.Errorf("reviewers = %v, want [%s]", pr.Reviewers, tt.wantRev)
+			}
+
+			// A redelivery returns the pull request created the first time.
+			again, err := f.svc.SyncExternalPullRequest(context.Background(), event)
+			if err != nil {
+				t.Fatalf("redelivery: %v", err)
+			}
+			if again.TeamName != pr.TeamName || len(again.Reviewers) != len(pr.Reviewers) {
+				t.Errorf("redelivery = %+v, want %+v", again, pr)
+			}
+		})
+	}
+}
+
+func TestSyncExternalPullRequestNotOpened(t *testing.T) {
+	archived := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		event   domain.ExternalPREvent
+		archive bool
+		want    error
+	}{
+		{
+			name:  "author not reported",
+			event: domain.ExternalPREvent{Action: domain.ExternalPROpened, PullRequestID: "p1"},
+			want:  domain.ErrNotFound,
+		},
+		{
+			name:  "author not mapped",
+			event: domain.ExternalPREvent{Action: domain.ExternalPROpened, PullRequestID: "p1", AuthorLogin: "mallory"},
+			want:  domain.ErrNotFound,
+		},
+		{
+			name:    "team archived",
+			event:   domain.ExternalPREvent{Action: domain.ExternalPROpened, PullRequestID: "p1", AuthorLogin: "alice-dev"},
+			archive: true,
+			want:    domain.ErrTeamArchived,
+		},
+		{
+			name:  "unknown action",
+			event: domain.ExternalPREvent{Action: "labeled", PullRequestID: "p1"},
+			want:  domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newIntegrationFixture()
+			if tt.archive {
+				team := f.teams.teams["backend"]
+				team.ArchivedAt = &archived
+				f.teams.teams["backend"] = team
+			}
+			tt.event.Provider = domain.ProviderGitHub
+
+			_, err := f.svc.SyncExternalPullRequest(context.Background(), tt.event)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+			if len(f.prs.prs) != 0 {
+				t.Errorf("created %d pull requests, want none", len(f.prs.prs))
+			}
+		})
+	}
+}
+
+func TestSyncExternalPullRequestUpdated(t *testing.T) {
+	f := newIntegrationFixture()
+	f.prs.prs["p1"] = domain.PullRequest{ID: "p1", Name: "Old title", AuthorID: "alice", TeamName: "backend", Status: domain.PRStatusOpen}
+
+	event := domain.ExternalPREvent{Provider: domain.ProviderGitLab, Action: domain.ExternalPRUpdated, PullRequestID: "p1", Title: "New title"}
+	pr, err := f.svc.SyncExternalPullRequest(context.Background(), event)
+	if err != nil {
+		t.Fatalf("SyncExternalPullRequest: %v", err)
+	}
+	if pr.Name != "New title" || f.prs.prs["p1"].Name != "New title" {
+		t.Errorf("name = %q, stored %q; want %q", pr.Name, f.prs.prs["p1"].Name, "New title")
+	}
+
+	// An update about a pull request we have not seen opens it.
+	event = domain.ExternalPREvent{Provider: domain.ProviderGitLab, Action: domain.ExternalPRUpdated, PullRequestID: "p2", Title: "Missed", AuthorLogin: "alice-dev"}
+	if _, err := f.svc.SyncExternalPullRequest(context.Background(), event); err != nil {
+		t.Fatalf("SyncExternalPullRequest: %v", err)
+	}
+	if got := f.prs.prs["p2"]; got.Status != domain.PRStatusOpen || got.Name != "Missed" {
+		t.Errorf("p2 = %+v, want an open pull request named %q", got, "Missed")
+	}
+}
+
+func TestSetExternalMappingRequiresAdmin(t *testing.T) {
+	tests := []struct {
+		name  string
+		actor domain.Actor
+		want  error
+	}{
+		{name: "team lead", actor: domain.Actor{UserID: "alice"}, want: domain.ErrAdminOnly},
+		{name: "anonymous", want: domain.ErrAdminOnly},
+		{name: "admin", actor: domain.Actor{Admin: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newIntegrationFixture()
+			ctx := context.Background()
+
+			if err := f.svc.SetExternalUser(ctx, tt.actor, domain.ProviderGitHub, "bob-dev", "bob"); !errors.Is(err, tt.want) {
+				t.Errorf("SetExternalUser err = %v, want %v", err, tt.want)
+			}
+			if err := f.svc.SetExternalTeam(ctx, tt.actor, domain.ProviderGitHub, "platform", "backend"); !errors.Is(err, tt.want) {
+				t.Errorf("SetExternalTeam err = %v, want %v", err, tt.want)
+			}
+			wantChanged := 2
+			if tt.want != nil {
+				wantChanged = 0
+			}
+			if f.integ.changed != wantChanged {
+				t.Errorf("mappings changed %d times, want %d", f.integ.changed, wantChanged)
+			}
+		})
+	}
+}
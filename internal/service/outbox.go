@@ -0,0 +1,64 @@
+package service
+
+import (
+	"avito-test-task/internal/domain"
+	"avito-test-task/internal/repository"
+	"context"
+)
+
+// EventSink receives the domain events relayed from the outbox. An event may be
+// published more than once, so a sink must ignore events whose ID it has seen.
+type EventSink interface {
+	Publish(ctx context.Context, e domain.Event) error
+}
+
+const relayBatchSize = 100
+
+// webhookSink schedules webhook deliveries of the events it receives.
+type webhookSink struct {
+	repo repository.WebhookRepository
+}
+
+func (w webhookSink) Publish(ctx context.Context, e domain.Event) error {
+	_, err := w.repo.Enqueue(ctx, []domain.Event{e})
+	return err
+}
+
+// RelayEvents publishes the events recorded in the outbox to every sink and
+// returns how many were published. An event that a sink rejected stays in the
+// outbox and is published to all sinks again by a later run, along with the
+// later events of the same pull request or user, so that they are not
+// published ahead of it; events of other keys go on. It is meant to be called
+// periodically.
+func (s *service) RelayEvents(ctx context.Context) (int, error) {
+	sinks := append([]EventSink{webhookSink{repo: s.webhookRepo}}, s.cfg.Sinks...)
+
+	return s.outboxRepo.Relay(ctx, relayBatchSize, func(ctx context.Context, events []domain.Event) ([]string, error) {
+		var ids []string
+		var firstErr error
+		blocked := make(map[string]bool)
+		for _, e := range events {
+			if blocked[e.Key] {
+				continue
+			}
+			if err := publishToSinks(ctx, sinks, e); err != nil {
+				blocked[e.Key] = true
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+			ids = append(ids, e.ID)
+		}
+		return ids, firstErr
+	})
+}
+
+func publishToSinks(ctx context.Context, sinks []EventSink, e domain.Event) error {
+	for _, sink := range sinks {
+		if err := sink.Publish(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
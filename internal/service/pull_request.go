@@ -3,7 +3,8 @@ package service
 import (
 	"avito-test-task/internal/domain"
 	"context"
-	"math/rand"
+	"fmt"
+	"slices"
 	"time"
 )
 
@@ -13,56 +14,127 @@ func (s *service) CreatePR(ctx context.Context, pr domain.PullRequest) (domain.P
 		return domain.PullRequest{}, err
 	}
 
-	// Get candidates, only active ones from the same team
-	candidates, err := s.userRepo.GetActiveUsersByTeam(ctx, author.TeamName)
-	if err != nil {
+	// Without an explicit team the PR belongs to the author's primary team.
+	if pr.TeamName == "" {
+		pr.TeamName = author.TeamName
+	} else if pr.TeamName, err = s.resolveTeamName(ctx, pr.TeamName); err != nil {
 		return domain.PullRequest{}, err
+	} else if pr.TeamName != author.TeamName {
+		memberships, err := s.userRepo.GetMemberships(ctx, author.ID)
+		if err != nil {
+			return domain.PullRequest{}, err
+		}
+		if !slices.ContainsFunc(memberships, func(m domain.Membership) bool { return m.TeamName == pr.TeamName }) {
+			return domain.PullRequest{}, fmt.Errorf("%w: author is not a member of team %q", domain.ErrInvalidInput, pr.TeamName)
+		}
 	}
 
-	// Filtering the author
-	validCandidates := make([]string, 0, len(candidates))
-	for _, c := range candidates {
-		if c.ID != pr.AuthorID {
-			validCandidates = append(validCandidates, c.ID)
-		}
+	team, err := s.teamRepo.GetTeamInfo(ctx, pr.TeamName)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	if team.ArchivedAt != nil {
+		return domain.PullRequest{}, domain.ErrTeamArchived
 	}
 
+	validCandidates, err := s.reviewerCandidates(ctx, team, map[string]bool{pr.AuthorID: true})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
 	if len(validCandidates) == 0 {
+		s.cfg.Recorder.NoCandidate(team.Name)
 		return domain.PullRequest{}, domain.ErrNoCandidate
 	}
 
-	rand.Shuffle(len(validCandidates), func(i, j int) {
-		validCandidates[i], validCandidates[j] = validCandidates[j], validCandidates[i]
-	})
+	now := time.Now()
+	domain.OrderCandidates(validCandidates, team.Settings.ReviewerSelection, now)
 
-	assignCount := 2
-	if len(validCandidates) < 2 {
-		assignCount = len(validCandidates)
-	}
+	assignCount := min(team.Settings.ReviewerCount, len(validCandidates))
 
-	pr.Reviewers = validCandidates[:assignCount]
+	pr.Reviewers = make([]string, assignCount)
+	for i := range pr.Reviewers {
+		pr.Reviewers[i] = validCandidates[i].ID
+	}
 	pr.Status = domain.PRStatusOpen
-	pr.CreatedAt = time.Now()
+	pr.CreatedAt = now
 
 	if err := s.prRepo.Create(ctx, pr); err != nil {
 		return domain.PullRequest{}, err
 	}
+	s.cfg.Recorder.PullRequestCreated(pr.TeamName)
 
 	return pr, nil
 }
 
+// MergePR merges the pull request if the review decisions satisfy the merge
+// policy of its team.
 func (s *service) MergePR(ctx context.Context, prID string) (domain.PullRequest, error) {
-	return s.prRepo.Merge(ctx, prID)
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	team, err := s.teamRepo.GetTeamInfo(ctx, pr.TeamName)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	return s.mergePR(ctx, prID, team.Settings.MergePolicy)
 }
 
-func (s *service) ReassignReviewer(ctx context.Context, prID, oldUserID string) (domain.PullRequest, string, error) {
+func (s *service) mergePR(ctx context.Context, prID string, policy domain.MergePolicy) (domain.PullRequest, error) {
+	pr, merged, err := s.prRepo.Merge(ctx, prID, policy)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	if merged {
+		s.cfg.Recorder.PullRequestMerged(pr.TeamName)
+	}
+	return pr, nil
+}
+
+// ClosePR closes an OPEN pull request without merging it. Its reviewers stay
+// assigned but no longer count as open reviews. Closing is idempotent.
+func (s *service) ClosePR(ctx context.Context, prID string) (domain.PullRequest, error) {
+	pr, _, err := s.prRepo.SetClosed(ctx, prID, true)
+	return pr, err
+}
+
+// ReopenPR reopens a CLOSED pull request. Slots of reviewers who became
+// unavailable meanwhile are refilled by the team backfill.
+func (s *service) ReopenPR(ctx context.Context, prID string) (domain.PullRequest, error) {
+	pr, reopened, err := s.prRepo.SetClosed(ctx, prID, false)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	if reopened {
+		s.backfillTeams(ctx, pr.TeamName)
+		return s.prRepo.GetByID(ctx, prID)
+	}
+	return pr, nil
+}
+
+// ReassignReviewer replaces a reviewer with another member of the team that owns
+// the PR. Reviewers may hand over their own slot; replacing someone else
+// requires a lead of that team. When nobody can take the slot over, the policy
+// decides whether to fail or to take the reviewer off anyway, either dropping
+// the slot or parking it for backfill. The new reviewer is empty in that case.
+func (s *service) ReassignReviewer(ctx context.Context, actor domain.Actor, prID, oldUserID string, policy domain.NoCandidatePolicy) (domain.PullRequest, string, domain.ReassignOutcome, error) {
+	if policy == "" {
+		policy = domain.NoCandidateFail
+	}
+	if !policy.Valid() {
+		return domain.PullRequest{}, "", "", fmt.Errorf("%w: unknown no-candidate policy %q", domain.ErrInvalidInput, policy)
+	}
+
 	pr, err := s.prRepo.GetByID(ctx, prID)
 	if err != nil {
-		return domain.PullRequest{}, "", domain.ErrNotFound
+		return domain.PullRequest{}, "", "", domain.ErrNotFound
 	}
 
-	if pr.Status == domain.PRStatusMerged {
-		return domain.PullRequest{}, "", domain.ErrPRMerged
+	switch pr.Status {
+	case domain.PRStatusMerged:
+		return domain.PullRequest{}, "", "", domain.ErrPRMerged
+	case domain.PRStatusClosed:
+		return domain.PullRequest{}, "", "", domain.ErrPRClosed
 	}
 
 	isAssigned := false
@@ -73,45 +145,55 @@ func (s *service) ReassignReviewer(ctx context.Context, prID, oldUserID string)
 		}
 	}
 	if !isAssigned {
-		return domain.PullRequest{}, "", domain.ErrNotAssigned
+		return domain.PullRequest{}, "", "", domain.ErrNotAssigned
 	}
 
-	oldUser, err := s.userRepo.GetByID(ctx, oldUserID)
-	if err != nil {
-		return domain.PullRequest{}, "", domain.ErrNotFound
+	if actor.UserID != oldUserID {
+		if err := s.requireLead(ctx, actor, pr.TeamName); err != nil {
+			return domain.PullRequest{}, "", "", err
+		}
 	}
 
-	candidates, err := s.userRepo.GetActiveUsersByTeam(ctx, oldUser.TeamName)
+	team, err := s.teamRepo.GetTeamInfo(ctx, pr.TeamName)
 	if err != nil {
-		return domain.PullRequest{}, "", err
+		return domain.PullRequest{}, "", "", err
 	}
 
-	validCandidates := make([]string, 0)
-
-	currentReviewersMap := make(map[string]bool)
+	exclude := map[string]bool{pr.AuthorID: true}
 	for _, r := range pr.Reviewers {
-		currentReviewersMap[r] = true
+		exclude[r] = true
 	}
 
-	for _, c := range candidates {
-		if c.ID == pr.AuthorID {
-			continue
+	validCandidates, err := s.reviewerCandidates(ctx, team, exclude)
+	if err != nil {
+		return domain.PullRequest{}, "", "", err
+	}
+	if len(validCandidates) == 0 {
+		if policy == domain.NoCandidateFail {
+			s.cfg.Recorder.NoCandidate(team.Name)
+			return domain.PullRequest{}, "", "", domain.ErrNoCandidate
 		}
-		if currentReviewersMap[c.ID] {
-			continue
+
+		park := policy == domain.NoCandidatePark
+		if err := s.prRepo.RemoveReviewer(ctx, prID, oldUserID, park); err != nil {
+			return domain.PullRequest{}, "", "", err
 		}
-		validCandidates = append(validCandidates, c.ID)
-	}
+		pr.Reviewers = slices.DeleteFunc(pr.Reviewers, func(r string) bool { return r == oldUserID })
 
-	if len(validCandidates) == 0 {
-		return domain.PullRequest{}, "", domain.ErrNoCandidate
+		if park {
+			return pr, "", domain.OutcomeParked, nil
+		}
+		pr.RemovedSlots++
+		return pr, "", domain.OutcomeRemoved, nil
 	}
 
-	newReviewerID := validCandidates[rand.Intn(len(validCandidates))]
+	domain.OrderCandidates(validCandidates, team.Settings.ReviewerSelection, time.Now())
+	newReviewerID := validCandidates[0].ID
 
 	if err := s.prRepo.UpdateReviewer(ctx, prID, oldUserID, newReviewerID); err != nil {
-		return domain.PullRequest{}, "", err
+		return domain.PullRequest{}, "", "", err
 	}
+	s.cfg.Recorder.ReviewsReassigned(domain.ReassignManual, 1)
 
 	for i, r := range pr.Reviewers {
 		if r == oldUserID {
@@ -120,5 +202,73 @@ func (s *service) ReassignReviewer(ctx context.Context, prID, oldUserID string)
 		}
 	}
 
-	return pr, newReviewerID, nil
+	return pr, newReviewerID, domain.OutcomeReplaced, nil
+}
+
+// DecideReview records the acting reviewer's decision on an OPEN pull request.
+func (s *service) DecideReview(ctx context.Context, actor domain.Actor, prID string, decision domain.ReviewDecision) (domain.PullRequest, error) {
+	if !decision.Valid() {
+		return domain.PullRequest{}, fmt.Errorf("%w: unknown decision %q", domain.ErrInvalidInput, decision)
+	}
+	if actor.UserID == "" {
+		return domain.PullRequest{}, domain.ErrUnauthorized
+	}
+
+	if err := s.prRepo.RecordDecision(ctx, prID, actor.UserID, decision); err != nil {
+		return domain.PullRequest{}, err
+	}
+	return s.prRepo.GetByID(ctx, prID)
+}
+
+// GetReviewSLA reports how much of each reviewer's working time has passed since
+// they were assigned. The clocks stop when the pull request is merged or closed.
+func (s *service) GetReviewSLA(ctx context.Context, prID string) (domain.PullRequestSLA, error) {
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return domain.PullRequestSLA{}, err
+	}
+
+	team, err := s.teamRepo.GetTeamInfo(ctx, pr.TeamName)
+	if err != nil {
+		return domain.PullRequestSLA{}, err
+	}
+
+	assignments, err := s.prRepo.GetAssignments(ctx, prID)
+	if err != nil {
+		return domain.PullRequestSLA{}, err
+	}
+
+	end := time.Now()
+	if pr.MergedAt != nil {
+		end = *pr.MergedAt
+	} else if pr.ClosedAt != nil {
+		end = *pr.ClosedAt
+	}
+
+	result := domain.PullRequestSLA{
+		PullRequestID:  pr.ID,
+		ReviewSLAHours: team.Settings.ReviewSLAHours,
+		Reviewers:      make([]domain.ReviewerSLA, 0, len(assignments)),
+	}
+	for _, a := range assignments {
+		reviewer, err := s.userRepo.GetByID(ctx, a.ReviewerID)
+		if err != nil {
+			return domain.PullRequestSLA{}, err
+		}
+
+		wh := reviewer.WorkingHours
+		clock := domain.ReviewerSLA{
+			ReviewerID:      a.ReviewerID,
+			AssignedAt:      a.AssignedAt,
+			BusinessElapsed: wh.BusinessTime(a.AssignedAt, end),
+		}
+		if sla := team.Settings.ReviewSLAHours; sla != nil {
+			due := wh.AddBusinessTime(a.AssignedAt, time.Duration(*sla)*time.Hour)
+			clock.DueAt = &due
+			clock.Overdue = end.After(due)
+		}
+		result.Reviewers = append(result.Reviewers, clock)
+	}
+
+	return result, nil
 }
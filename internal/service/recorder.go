@@ -0,0 +1,43 @@
+package service
+
+import "avito-test-task/internal/domain"
+
+// Outcomes reported to Recorder.WebhookDelivered.
+const (
+	WebhookSucceeded = "succeeded"
+	WebhookRetried   = "retried"
+	WebhookFailed    = "failed"
+)
+
+// Recorder is notified of domain events worth monitoring.
+type Recorder interface {
+	PullRequestCreated(teamName string)
+	PullRequestMerged(teamName string)
+	ReviewsReassigned(reason string, count int)
+	NoCandidate(teamName string)
+	WebhookDelivered(outcome string)
+}
+
+type nopRecorder struct{}
+
+func (nopRecorder) PullRequestCreated(string)     {}
+func (nopRecorder) PullRequestMerged(string)      {}
+func (nopRecorder) ReviewsReassigned(string, int) {}
+func (nopRecorder) NoCandidate(string)            {}
+func (nopRecorder) WebhookDelivered(string)       {}
+
+// recordReassigned reports the slots that actually moved to another reviewer,
+// and a missing candidate for each of the others.
+func (s *service) recordReassigned(reason string, reassigned []domain.Reassignment) {
+	n := 0
+	for _, ra := range reassigned {
+		if ra.NewReviewerID != "" {
+			n++
+		} else {
+			s.cfg.Recorder.NoCandidate(ra.TeamName)
+		}
+	}
+	if n > 0 {
+		s.cfg.Recorder.ReviewsReassigned(reason, n)
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"errors"
+	"time"
+)
+
+// requireLead checks that the actor leads the team or one of its ancestors, or
+// holds the admin token.
+func (s *service) requireLead(ctx context.Context, actor domain.Actor, teamName string) error {
+	return s.checkLead(ctx, actor, teamName, true)
+}
+
+// requireTeamLead is requireLead without the ancestors. Roles are changed this
+// way, so that leading a parent team does not let anyone appoint themselves or
+// others in its sub-teams.
+func (s *service) requireTeamLead(ctx context.Context, actor domain.Actor, teamName string) error {
+	return s.checkLead(ctx, actor, teamName, false)
+}
+
+func (s *service) checkLead(ctx context.Context, actor domain.Actor, teamName string, inherited bool) error {
+	if actor.Admin {
+		return nil
+	}
+	if actor.UserID == "" {
+		return domain.ErrUnauthorized
+	}
+
+	isLead, err := s.teamRepo.IsLead(ctx, teamName, actor.UserID, inherited)
+	if err != nil {
+		return err
+	}
+	if !isLead {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+// requireSelfOrLead checks that the actor is the user, leads the user's
+// primary team or one of its ancestors, or holds the admin token.
+func (s *service) requireSelfOrLead(ctx context.Context, actor domain.Actor, user domain.User) error {
+	if actor.UserID != "" && actor.UserID == user.ID {
+		return nil
+	}
+	return s.requireLead(ctx, actor, user.TeamName)
+}
+
+func requireAdmin(actor domain.Actor) error {
+	if !actor.Admin {
+		return domain.ErrAdminOnly
+	}
+	return nil
+}
+
+// requireMoveLeads checks that the actor leads the current team of every member
+// that ConflictPolicyMove would take from another team. Users that do not exist
+// yet are created by the team and need no check.
+func (s *service) requireMoveLeads(ctx context.Context, actor domain.Actor, team domain.Team) error {
+	if actor.Admin {
+		return nil
+	}
+	checked := make(map[string]bool)
+	for _, m := range team.Members {
+		user, err := s.userRepo.GetByID(ctx, m.ID)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if user.TeamName == team.Name || checked[user.TeamName] {
+			continue
+		}
+		if err := s.requireLead(ctx, actor, user.TeamName); err != nil {
+			return err
+		}
+		checked[user.TeamName] = true
+	}
+	return nil
+}
+
+// EscalateOverdueReviews adds a lead of the owning team as an extra reviewer to
+// every pull request whose reviewer has run past the team's review SLA. Each
+// overdue slot is escalated once; it is retried later if no lead is available.
+// Leads of all affected teams are looked up at once and the escalations are
+// stored in a single transaction.
+func (s *service) EscalateOverdueReviews(ctx context.Context) ([]domain.Escalation, error) {
+	open, err := s.prRepo.ListOpenReviews(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	teams := make(map[string]domain.Team)
+	var overdue []domain.OpenReview
+	var teamNames []string
+	for _, o := range open {
+		team, ok := teams[o.TeamName]
+		if !ok {
+			team, err = s.teamRepo.GetTeamInfo(ctx, o.TeamName)
+			if err != nil {
+				return nil, err
+			}
+			teams[o.TeamName] = team
+			if team.Settings.ReviewSLAHours != nil {
+				teamNames = append(teamNames, team.Name)
+			}
+		}
+		sla := team.Settings.ReviewSLAHours
+		if sla == nil {
+			continue
+		}
+
+		due := o.ReviewerHours.AddBusinessTime(o.AssignedAt, time.Duration(*sla)*time.Hour)
+		if now.Before(due) {
+			continue
+		}
+		overdue = append(overdue, o)
+	}
+	if len(overdue) == 0 {
+		return nil, nil
+	}
+
+	contacts, err := s.userRepo.GetEscalationContacts(ctx, teamNames, maxEscalationDepth-1)
+	if err != nil {
+		return nil, err
+	}
+
+	// Leads picked earlier in this run already review the pull request.
+	added := make(map[string][]string)
+	var escalations []domain.Escalation
+	for _, o := range overdue {
+		leadID := escalationTarget(contacts[o.TeamName], o, added[o.PullRequestID])
+		if leadID == "" {
+			continue
+		}
+		added[o.PullRequestID] = append(added[o.PullRequestID], leadID)
+		escalations = append(escalations, domain.Escalation{PullRequestID: o.PullRequestID, OverdueReviewerID: o.ReviewerID, LeadID: leadID})
+	}
+
+	return s.prRepo.Escalate(ctx, escalations)
+}
+
+// escalationTarget picks the first of the team's escalation contacts who is
+// neither the author nor already reviewing. It returns an empty ID when nobody
+// qualifies.
+func escalationTarget(contacts []domain.User, o domain.OpenReview, added []string) string {
+	exclude := map[string]bool{o.AuthorID: true}
+	for _, r := range o.Reviewers {
+		exclude[r] = true
+	}
+	for _, r := range added {
+		exclude[r] = true
+	}
+
+	for _, c := range contacts {
+		if !exclude[c.ID] {
+			return c.ID
+		}
+	}
+	return ""
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+)
+
+// maxEscalationDepth bounds how far up the hierarchy reviewer escalation may go.
+const maxEscalationDepth = 32
+
+// reviewerCandidates returns available members of the team without the excluded
+// users. If there are none and the team escalates to its parent, the parent
+// team's whole subtree is used instead, going further up while still empty.
+func (s *service) reviewerCandidates(ctx context.Context, team domain.Team, exclude map[string]bool) ([]domain.User, error) {
+	users, err := s.userRepo.GetAvailableUsersByTeam(ctx, team.Name)
+	if err != nil {
+		return nil, err
+	}
+	candidates := filterCandidates(users, exclude)
+
+	settings := team.Settings
+	for depth := 0; len(candidates) == 0 && settings.EscalateToParent && team.ParentName != nil && depth < maxEscalationDepth; depth++ {
+		team, err = s.teamRepo.GetTeamInfo(ctx, *team.ParentName)
+		if err != nil {
+			return nil, err
+		}
+		users, err = s.userRepo.GetAvailableUsersInSubtree(ctx, team.Name)
+		if err != nil {
+			return nil, err
+		}
+		candidates = filterCandidates(users, exclude)
+		settings = team.Settings
+	}
+
+	return candidates, nil
+}
+
+func filterCandidates(users []domain.User, exclude map[string]bool) []domain.User {
+	result := make([]domain.User, 0, len(users))
+	for _, u := range users {
+		if !exclude[u.ID] {
+			result = append(result, u)
+		}
+	}
+	return result
+}
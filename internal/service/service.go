@@ -4,23 +4,104 @@ import (
 	"avito-test-task/internal/domain"
 	"avito-test-task/internal/repository"
 	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 type Service interface {
-	CreateTeam(ctx context.Context, team domain.Team) error
+	CreateTeam(ctx context.Context, actor domain.Actor, team domain.Team, policy domain.ConflictPolicy) ([]domain.MemberConflict, error)
 	GetTeam(ctx context.Context, name string) (domain.Team, error)
-	SetUserActive(ctx context.Context, userID string, isActive bool) (domain.User, error)
+	ListTeams(ctx context.Context, filter domain.TeamFilter, page domain.PageRequest) ([]domain.TeamSummary, string, error)
+	DeactivateTeamMembers(ctx context.Context, actor domain.Actor, teamName string, userIDs []string, allExcept bool) ([]string, []domain.Reassignment, error)
+	ArchiveTeam(ctx context.Context, actor domain.Actor, name string) (time.Time, []string, []domain.Reassignment, error)
+	DeleteTeam(ctx context.Context, actor domain.Actor, name string) error
+	RenameTeam(ctx context.Context, actor domain.Actor, name, newName string) (domain.TeamRename, error)
+	UpdateTeamSettings(ctx context.Context, actor domain.Actor, name string, update domain.TeamSettingsUpdate) (domain.Team, error)
+	SetTeamMemberRole(ctx context.Context, actor domain.Actor, teamName, userID string, role domain.TeamRole) (domain.User, error)
+	AddTeamMember(ctx context.Context, actor domain.Actor, teamName, userID string, role domain.TeamRole) (domain.User, error)
+	RemoveTeamMember(ctx context.Context, actor domain.Actor, teamName, userID string) ([]domain.Reassignment, error)
+	SetTeamParent(ctx context.Context, actor domain.Actor, name string, parent *string) (domain.Team, error)
+	GetTeamTree(ctx context.Context, root *string, includeArchived bool) ([]domain.TeamNode, error)
+	GetUser(ctx context.Context, userID string) (domain.UserProfile, error)
+	UpdateUser(ctx context.Context, actor domain.Actor, userID string, update domain.UserUpdate) (domain.UserProfile, error)
+	ListUsers(ctx context.Context, filter domain.UserFilter, page domain.PageRequest) ([]domain.User, string, error)
+	SetUserActive(ctx context.Context, actor domain.Actor, userID string, isActive bool, reassign *bool) (domain.User, []domain.Reassignment, error)
+	TransferUser(ctx context.Context, actor domain.Actor, userID, teamName string, policy domain.ReviewPolicy) (domain.User, []domain.Reassignment, error)
 	GetUserReviews(ctx context.Context, userID string) ([]domain.PullRequest, error)
+	IssueActorToken(ctx context.Context, actor domain.Actor, userID string, ttl time.Duration) (string, time.Time, error)
+
+	CreateAbsence(ctx context.Context, actor domain.Actor, a domain.Absence) (domain.Absence, []domain.Reassignment, error)
+	ListAbsences(ctx context.Context, userID string, includePast bool) ([]domain.Absence, error)
+	UpdateAbsence(ctx context.Context, actor domain.Actor, id int64, update domain.AbsenceUpdate) (domain.Absence, []domain.Reassignment, error)
+	DeleteAbsence(ctx context.Context, actor domain.Actor, id int64) error
+	ReassignStartedAbsences(ctx context.Context) ([]domain.Reassignment, error)
 
 	CreatePR(ctx context.Context, req domain.PullRequest) (domain.PullRequest, error)
 	MergePR(ctx context.Context, prID string) (domain.PullRequest, error)
-	ReassignReviewer(ctx context.Context, prID, oldUserID string) (domain.PullRequest, string, error)
+	ClosePR(ctx context.Context, prID string) (domain.PullRequest, error)
+	ReopenPR(ctx context.Context, prID string) (domain.PullRequest, error)
+	ReassignReviewer(ctx context.Context, actor domain.Actor, prID, oldUserID string, policy domain.NoCandidatePolicy) (domain.PullRequest, string, domain.ReassignOutcome, error)
+	DecideReview(ctx context.Context, actor domain.Actor, prID string, decision domain.ReviewDecision) (domain.PullRequest, error)
+	GetReviewSLA(ctx context.Context, prID string) (domain.PullRequestSLA, error)
+	EscalateOverdueReviews(ctx context.Context) ([]domain.Escalation, error)
+	BackfillReviewers(ctx context.Context) ([]domain.Backfill, error)
+	BackfillTeamReviewers(ctx context.Context, actor domain.Actor, teamName string) ([]domain.Backfill, error)
+
+	GetReviewerStats(ctx context.Context, teamName string, window domain.StatsWindow) (domain.ReviewerStats, error)
+	GetTeamDeliveryStats(ctx context.Context, teamName string, window domain.StatsWindow) (domain.TeamDeliveryStats, error)
+	OpenReviewLoad(ctx context.Context) (map[string]int, error)
+	GetFairnessReport(ctx context.Context, teamName string, window domain.StatsWindow, giniThreshold float64) ([]domain.TeamFairness, error)
+	RefreshStatsRollups(ctx context.Context) (int, error)
+	RebuildStatsRollups(ctx context.Context, from, to time.Time) error
+	ExportData(ctx context.Context, req domain.ExportRequest) (domain.ExportPage, error)
+
+	SetExternalUser(ctx context.Context, actor domain.Actor, provider domain.Provider, login, userID string) error
+	SetExternalTeam(ctx context.Context, actor domain.Actor, provider domain.Provider, externalTeam, teamName string) error
+	ListExternalMappings(ctx context.Context, provider domain.Provider) ([]domain.ExternalUser, []domain.ExternalTeam, error)
+	SyncExternalPullRequest(ctx context.Context, event domain.ExternalPREvent) (domain.PullRequest, error)
+
+	CreateWebhookSubscription(ctx context.Context, actor domain.Actor, sub domain.WebhookSubscription) (domain.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context, actor domain.Actor) ([]domain.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, actor domain.Actor, id int64) error
+	ListWebhookDeliveries(ctx context.Context, actor domain.Actor, filter domain.DeliveryFilter, page domain.PageRequest) ([]domain.WebhookDelivery, string, error)
+	RedeliverWebhook(ctx context.Context, actor domain.Actor, deliveryID int64) (domain.WebhookDelivery, error)
+	DeliverWebhooks(ctx context.Context) (int, error)
+	RelayEvents(ctx context.Context) (int, error)
+}
+
+// Config holds the tunables and hooks of the service layer.
+type Config struct {
+	// TeamAliasTTL is how long the old name of a renamed team keeps working.
+	TeamAliasTTL time.Duration
+	// Recorder receives domain events for monitoring; nil discards them.
+	Recorder Recorder
+	// PseudonymKey keys the hashes that replace user ids and usernames in
+	// pseudonymized exports; pseudonymization is unavailable without it.
+	PseudonymKey []byte
+	// WebhookSender sends queued webhook deliveries; without it they stay
+	// queued.
+	WebhookSender WebhookSender
+	// Sinks receive the domain events relayed from the outbox, in addition
+	// to the webhook subscriptions.
+	Sinks []EventSink
+	// ActorTokenSecret signs the tokens issued by IssueActorToken; none are
+	// issued without it.
+	ActorTokenSecret string
 }
 
 type service struct {
-	teamRepo repository.TeamRepository
-	userRepo repository.UserRepository
-	prRepo   repository.PullRequestRepository
+	teamRepo    repository.TeamRepository
+	userRepo    repository.UserRepository
+	prRepo      repository.PullRequestRepository
+	absenceRepo repository.AbsenceRepository
+	statsRepo   repository.StatsRepository
+	integRepo   repository.IntegrationRepository
+	webhookRepo repository.WebhookRepository
+	outboxRepo  repository.OutboxRepository
+
+	cfg Config
 }
 
 var _ Service = (*service)(nil)
@@ -29,24 +110,433 @@ func NewService(
 	t repository.TeamRepository,
 	u repository.UserRepository,
 	p repository.PullRequestRepository,
+	a repository.AbsenceRepository,
+	st repository.StatsRepository,
+	ir repository.IntegrationRepository,
+	wr repository.WebhookRepository,
+	or repository.OutboxRepository,
+	cfg Config,
 ) *service {
+	if cfg.Recorder == nil {
+		cfg.Recorder = nopRecorder{}
+	}
 	return &service{
-		teamRepo: t,
-		userRepo: u,
-		prRepo:   p,
+		teamRepo:    t,
+		userRepo:    u,
+		prRepo:      p,
+		absenceRepo: a,
+		statsRepo:   st,
+		integRepo:   ir,
+		webhookRepo: wr,
+		outboxRepo:  or,
+		cfg:         cfg,
 	}
 }
 
-func (s *service) CreateTeam(ctx context.Context, team domain.Team) error {
-	return s.teamRepo.CreateTeamWithMembers(ctx, team)
+// CreateTeam creates a team with its members and returns the members skipped
+// under ConflictPolicySkip. A payload that lists a user twice is always rejected.
+// Only the admin may hand out roles above member, and moving users in from
+// other teams takes a lead of each of those teams.
+func (s *service) CreateTeam(ctx context.Context, actor domain.Actor, team domain.Team, policy domain.ConflictPolicy) ([]domain.MemberConflict, error) {
+	if !policy.Valid() {
+		return nil, fmt.Errorf("%w: unknown conflict policy %q", domain.ErrInvalidInput, policy)
+	}
+
+	seen := make(map[string]int, len(team.Members))
+	var duplicates []domain.MemberConflict
+	for _, m := range team.Members {
+		seen[m.ID]++
+		if seen[m.ID] == 2 {
+			duplicates = append(duplicates, domain.MemberConflict{UserID: m.ID, Reason: domain.ConflictDuplicate})
+		}
+	}
+	if len(duplicates) > 0 {
+		return nil, &domain.MemberConflictError{Conflicts: duplicates}
+	}
+
+	for _, m := range team.Members {
+		if m.Role != domain.RoleMember {
+			if err := requireAdmin(actor); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	if policy == domain.ConflictPolicyMove {
+		if err := s.requireMoveLeads(ctx, actor, team); err != nil {
+			return nil, err
+		}
+	}
+
+	if team.ParentName != nil {
+		parent, err := s.resolveTeamName(ctx, *team.ParentName)
+		if err != nil {
+			return nil, err
+		}
+		team.ParentName = &parent
+	}
+	return s.teamRepo.CreateTeamWithMembers(ctx, team, policy)
 }
 
 func (s *service) GetTeam(ctx context.Context, name string) (domain.Team, error) {
+	name, err := s.resolveTeamName(ctx, name)
+	if err != nil {
+		return domain.Team{}, err
+	}
 	return s.teamRepo.GetTeamByName(ctx, name)
 }
 
-func (s *service) SetUserActive(ctx context.Context, userID string, isActive bool) (domain.User, error) {
-	return s.userRepo.SetIsActive(ctx, userID, isActive)
+func (s *service) ListTeams(ctx context.Context, filter domain.TeamFilter, page domain.PageRequest) ([]domain.TeamSummary, string, error) {
+	return s.teamRepo.List(ctx, filter, page)
+}
+
+func (s *service) DeactivateTeamMembers(ctx context.Context, actor domain.Actor, teamName string, userIDs []string, allExcept bool) ([]string, []domain.Reassignment, error) {
+	teamName, err := s.resolveTeamName(ctx, teamName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.requireLead(ctx, actor, teamName); err != nil {
+		return nil, nil, err
+	}
+	deactivated, reassigned, err := s.teamRepo.DeactivateMembers(ctx, teamName, userIDs, allExcept)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.recordReassigned(domain.ReassignDeactivation, reassigned)
+	return deactivated, reassigned, nil
+}
+
+// ArchiveTeam archives the team and deactivates its members. Their open reviews
+// are reassigned like on any other deactivation.
+func (s *service) ArchiveTeam(ctx context.Context, actor domain.Actor, name string) (time.Time, []string, []domain.Reassignment, error) {
+	name, err := s.resolveTeamName(ctx, name)
+	if err != nil {
+		return time.Time{}, nil, nil, err
+	}
+	if err := s.requireLead(ctx, actor, name); err != nil {
+		return time.Time{}, nil, nil, err
+	}
+	archivedAt, deactivated, reassigned, err := s.teamRepo.Archive(ctx, name)
+	if err != nil {
+		return time.Time{}, nil, nil, err
+	}
+	s.recordReassigned(domain.ReassignDeactivation, reassigned)
+	return archivedAt, deactivated, reassigned, nil
+}
+
+// DeleteTeam deletes an empty team. Aliases are not resolved here so that an
+// old name can never delete the renamed team by accident.
+func (s *service) DeleteTeam(ctx context.Context, actor domain.Actor, name string) error {
+	if err := s.requireLead(ctx, actor, name); err != nil {
+		return err
+	}
+	return s.teamRepo.Delete(ctx, name)
+}
+
+// RenameTeam renames the team everywhere it is referenced. The old name keeps
+// resolving to the team for the configured alias period.
+func (s *service) RenameTeam(ctx context.Context, actor domain.Actor, name, newName string) (domain.TeamRename, error) {
+	newName = strings.TrimSpace(newName)
+	if newName == "" {
+		return domain.TeamRename{}, fmt.Errorf("%w: new team name must not be empty", domain.ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(newName) > domain.MaxTeamNameLength {
+		return domain.TeamRename{}, fmt.Errorf("%w: team name is longer than %d characters", domain.ErrInvalidInput, domain.MaxTeamNameLength)
+	}
+
+	name, err := s.resolveTeamName(ctx, name)
+	if err != nil {
+		return domain.TeamRename{}, err
+	}
+	if name == newName {
+		return domain.TeamRename{}, fmt.Errorf("%w: team is already called %q", domain.ErrInvalidInput, newName)
+	}
+	if err := s.requireLead(ctx, actor, name); err != nil {
+		return domain.TeamRename{}, err
+	}
+
+	expiresAt := time.Now().Add(s.cfg.TeamAliasTTL)
+	if err := s.teamRepo.Rename(ctx, name, newName, expiresAt); err != nil {
+		return domain.TeamRename{}, err
+	}
+	return domain.TeamRename{OldName: name, NewName: newName, AliasExpiresAt: expiresAt}, nil
+}
+
+// resolveTeamName maps a former name of a renamed team to its current name.
+// Unknown names are returned unchanged.
+func (s *service) resolveTeamName(ctx context.Context, name string) (string, error) {
+	return s.teamRepo.ResolveName(ctx, name)
+}
+
+const maxReviewerCount = 10
+
+func (s *service) UpdateTeamSettings(ctx context.Context, actor domain.Actor, name string, update domain.TeamSettingsUpdate) (domain.Team, error) {
+	name, err := s.resolveTeamName(ctx, name)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if err := s.requireLead(ctx, actor, name); err != nil {
+		return domain.Team{}, err
+	}
+
+	set := update.Set
+	if sel := set.ReviewerSelection; sel != nil {
+		if *sel != domain.SelectionRandom && *sel != domain.SelectionWorkingHours {
+			return domain.Team{}, fmt.Errorf("%w: unknown reviewer selection %q", domain.ErrInvalidInput, *sel)
+		}
+	}
+	if sla := set.ReviewSLAHours; sla != nil && *sla < 0 {
+		return domain.Team{}, fmt.Errorf("%w: review SLA must not be negative", domain.ErrInvalidInput)
+	}
+	if n := set.ReviewerCount; n != nil && (*n < 1 || *n > maxReviewerCount) {
+		return domain.Team{}, fmt.Errorf("%w: reviewer count must be between 1 and %d", domain.ErrInvalidInput, maxReviewerCount)
+	}
+	if p := set.NoCandidatePolicy; p != nil && *p != domain.NoCandidatePark && *p != domain.NoCandidateRemove {
+		return domain.Team{}, fmt.Errorf("%w: no-candidate policy must be park or remove, got %q", domain.ErrInvalidInput, *p)
+	}
+	if p := set.MergePolicy; p != nil && !p.Valid() {
+		return domain.Team{}, fmt.Errorf("%w: unknown merge policy %q", domain.ErrInvalidInput, *p)
+	}
+	for _, setting := range update.Inherit {
+		switch setting {
+		case domain.SettingReassignOnDeactivate, domain.SettingReviewerSelection, domain.SettingReviewSLAHours,
+			domain.SettingReviewerCount, domain.SettingEscalateToParent, domain.SettingNoCandidatePolicy,
+			domain.SettingMergePolicy:
+		default:
+			return domain.Team{}, fmt.Errorf("%w: unknown setting %q", domain.ErrInvalidInput, setting)
+		}
+	}
+
+	return s.teamRepo.UpdateSettings(ctx, name, update)
+}
+
+// SetTeamMemberRole changes a member's role. Only a lead of the team itself may
+// do this; the first lead of a team is appointed with the admin token.
+func (s *service) SetTeamMemberRole(ctx context.Context, actor domain.Actor, teamName, userID string, role domain.TeamRole) (domain.User, error) {
+	if !role.Valid() {
+		return domain.User{}, fmt.Errorf("%w: unknown role %q", domain.ErrInvalidInput, role)
+	}
+
+	teamName, err := s.resolveTeamName(ctx, teamName)
+	if err != nil {
+		return domain.User{}, err
+	}
+	if err := s.requireTeamLead(ctx, actor, teamName); err != nil {
+		return domain.User{}, err
+	}
+
+	return s.teamRepo.SetMemberRole(ctx, teamName, userID, role)
+}
+
+// AddTeamMember adds an existing user to one more team. Adding a member again
+// changes their role, so it takes a lead of the team itself, as SetTeamMemberRole does.
+func (s *service) AddTeamMember(ctx context.Context, actor domain.Actor, teamName, userID string, role domain.TeamRole) (domain.User, error) {
+	if role == "" {
+		role = domain.RoleMember
+	}
+	if !role.Valid() {
+		return domain.User{}, fmt.Errorf("%w: unknown role %q", domain.ErrInvalidInput, role)
+	}
+	teamName, err := s.resolveTeamName(ctx, teamName)
+	if err != nil {
+		return domain.User{}, err
+	}
+	if err := s.requireTeamLead(ctx, actor, teamName); err != nil {
+		return domain.User{}, err
+	}
+
+	user, err := s.teamRepo.AddMember(ctx, teamName, userID, role)
+	if err != nil {
+		return domain.User{}, err
+	}
+	if user.IsActive {
+		s.backfillTeams(ctx, teamName)
+	}
+	return user, nil
+}
+
+// RemoveTeamMember takes the user out of a secondary team. Users may leave on
+// their own; removing someone else requires a lead.
+func (s *service) RemoveTeamMember(ctx context.Context, actor domain.Actor, teamName, userID string) ([]domain.Reassignment, error) {
+	teamName, err := s.resolveTeamName(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if actor.UserID != userID {
+		if err := s.requireLead(ctx, actor, teamName); err != nil {
+			return nil, err
+		}
+	}
+
+	reassigned, err := s.teamRepo.RemoveMember(ctx, teamName, userID)
+	if err != nil {
+		return nil, err
+	}
+	s.recordReassigned(domain.ReassignMembership, reassigned)
+	return reassigned, nil
+}
+
+// SetTeamParent moves the team under another parent. The actor must lead both
+// the team and the new parent; only the admin may detach a team to the top level.
+func (s *service) SetTeamParent(ctx context.Context, actor domain.Actor, name string, parent *string) (domain.Team, error) {
+	name, err := s.resolveTeamName(ctx, name)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if parent != nil {
+		resolved, err := s.resolveTeamName(ctx, *parent)
+		if err != nil {
+			return domain.Team{}, err
+		}
+		parent = &resolved
+	}
+	if parent != nil && *parent == name {
+		return domain.Team{}, fmt.Errorf("%w: team cannot be its own parent", domain.ErrInvalidInput)
+	}
+	if err := s.requireLead(ctx, actor, name); err != nil {
+		return domain.Team{}, err
+	}
+	if parent == nil {
+		err = requireAdmin(actor)
+	} else {
+		err = s.requireLead(ctx, actor, *parent)
+	}
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if err := s.teamRepo.SetParent(ctx, name, parent); err != nil {
+		return domain.Team{}, err
+	}
+	return s.teamRepo.GetTeamInfo(ctx, name)
+}
+
+func (s *service) GetTeamTree(ctx context.Context, root *string, includeArchived bool) ([]domain.TeamNode, error) {
+	if root != nil {
+		resolved, err := s.resolveTeamName(ctx, *root)
+		if err != nil {
+			return nil, err
+		}
+		root = &resolved
+	}
+	return s.teamRepo.GetTree(ctx, root, includeArchived)
+}
+
+func (s *service) GetUser(ctx context.Context, userID string) (domain.UserProfile, error) {
+	return s.userRepo.GetProfile(ctx, userID)
+}
+
+func (s *service) UpdateUser(ctx context.Context, actor domain.Actor, userID string, update domain.UserUpdate) (domain.UserProfile, error) {
+	if update.Username != nil {
+		username := strings.TrimSpace(*update.Username)
+		if username == "" {
+			return domain.UserProfile{}, fmt.Errorf("%w: username must not be empty", domain.ErrInvalidInput)
+		}
+		if utf8.RuneCountInString(username) > domain.MaxUsernameLength {
+			return domain.UserProfile{}, fmt.Errorf("%w: username is longer than %d characters", domain.ErrInvalidInput, domain.MaxUsernameLength)
+		}
+		update.Username = &username
+	}
+	if update.Timezone != nil {
+		if err := domain.ValidateTimezone(*update.Timezone); err != nil {
+			return domain.UserProfile{}, err
+		}
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return domain.UserProfile{}, err
+	}
+	if err := s.requireSelfOrLead(ctx, actor, user); err != nil {
+		return domain.UserProfile{}, err
+	}
+
+	if _, err := s.userRepo.Update(ctx, userID, update); err != nil {
+		return domain.UserProfile{}, err
+	}
+
+	return s.userRepo.GetProfile(ctx, userID)
+}
+
+func (s *service) ListUsers(ctx context.Context, filter domain.UserFilter, page domain.PageRequest) ([]domain.User, string, error) {
+	if filter.TeamName != nil {
+		resolved, err := s.resolveTeamName(ctx, *filter.TeamName)
+		if err != nil {
+			return nil, "", err
+		}
+		filter.TeamName = &resolved
+	}
+	return s.userRepo.List(ctx, filter, page)
+}
+
+// SetUserActive toggles the user's active flag. Users may change their own flag;
+// changing someone else's requires a lead of their team. On deactivation the
+// user's open reviews are handed over to other team members when reassign is
+// set, or, if it is nil, when the team has ReassignOnDeactivate enabled.
+func (s *service) SetUserActive(ctx context.Context, actor domain.Actor, userID string, isActive bool, reassign *bool) (domain.User, []domain.Reassignment, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return domain.User{}, nil, err
+	}
+	if err := s.requireSelfOrLead(ctx, actor, user); err != nil {
+		return domain.User{}, nil, err
+	}
+
+	doReassign := false
+	if !isActive {
+		if reassign != nil {
+			doReassign = *reassign
+		} else {
+			team, err := s.teamRepo.GetTeamInfo(ctx, user.TeamName)
+			if err != nil {
+				return domain.User{}, nil, err
+			}
+			doReassign = team.Settings.ReassignOnDeactivate
+		}
+	}
+
+	user, reassigned, err := s.userRepo.SetIsActive(ctx, userID, isActive, doReassign)
+	if err != nil {
+		return domain.User{}, nil, err
+	}
+	s.recordReassigned(domain.ReassignDeactivation, reassigned)
+	if isActive {
+		memberships, err := s.userRepo.GetMemberships(ctx, userID)
+		if err == nil {
+			teamNames := make([]string, len(memberships))
+			for i, m := range memberships {
+				teamNames[i] = m.TeamName
+			}
+			s.backfillTeams(ctx, teamNames...)
+		}
+	}
+	return user, reassigned, nil
+}
+
+// TransferUser changes the user's primary team. The actor must lead both the
+// team the user leaves and the one they join.
+func (s *service) TransferUser(ctx context.Context, actor domain.Actor, userID, teamName string, policy domain.ReviewPolicy) (domain.User, []domain.Reassignment, error) {
+	teamName, err := s.resolveTeamName(ctx, teamName)
+	if err != nil {
+		return domain.User{}, nil, err
+	}
+	current, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return domain.User{}, nil, err
+	}
+	for _, team := range []string{current.TeamName, teamName} {
+		if err := s.requireLead(ctx, actor, team); err != nil {
+			return domain.User{}, nil, err
+		}
+	}
+	user, reassigned, err := s.userRepo.TransferToTeam(ctx, userID, teamName, policy)
+	if err != nil {
+		return domain.User{}, nil, err
+	}
+	s.recordReassigned(domain.ReassignMembership, reassigned)
+	if user.IsActive {
+		s.backfillTeams(ctx, teamName)
+	}
+	return user, reassigned, nil
 }
 
 func (s *service) GetUserReviews(ctx context.Context, userID string) ([]domain.PullRequest, error) {
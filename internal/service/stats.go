@@ -0,0 +1,130 @@
+package service
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// GetReviewerStats reports review workload per reviewer and per team within the
+// window, widened to whole days. A non-empty team name limits it to pull
+// requests owned by that team.
+func (s *service) GetReviewerStats(ctx context.Context, teamName string, window domain.StatsWindow) (domain.ReviewerStats, error) {
+	if err := validateWindow(window); err != nil {
+		return domain.ReviewerStats{}, err
+	}
+	window = window.WholeDays()
+
+	if teamName != "" {
+		var err error
+		if teamName, err = s.resolveTeamName(ctx, teamName); err != nil {
+			return domain.ReviewerStats{}, err
+		}
+		if _, err := s.teamRepo.GetTeamInfo(ctx, teamName); err != nil {
+			return domain.ReviewerStats{}, err
+		}
+	}
+
+	return s.statsRepo.ReviewerStats(ctx, teamName, window)
+}
+
+// GetTeamDeliveryStats reports how quickly pull requests owned by the team are
+// decided and merged within the window, widened to whole days.
+func (s *service) GetTeamDeliveryStats(ctx context.Context, teamName string, window domain.StatsWindow) (domain.TeamDeliveryStats, error) {
+	if err := validateWindow(window); err != nil {
+		return domain.TeamDeliveryStats{}, err
+	}
+	window = window.WholeDays()
+
+	teamName, err := s.resolveTeamName(ctx, teamName)
+	if err != nil {
+		return domain.TeamDeliveryStats{}, err
+	}
+	if _, err := s.teamRepo.GetTeamInfo(ctx, teamName); err != nil {
+		return domain.TeamDeliveryStats{}, err
+	}
+
+	return s.statsRepo.TeamDeliveryStats(ctx, teamName, window)
+}
+
+// OpenReviewLoad returns the number of reviews on OPEN pull requests per reviewer.
+func (s *service) OpenReviewLoad(ctx context.Context) (map[string]int, error) {
+	return s.statsRepo.OpenReviewLoad(ctx)
+}
+
+// defaultFairnessWindow is used when the fairness report is asked for without a
+// start of the window.
+const defaultFairnessWindow = 30 * 24 * time.Hour
+
+// GetFairnessReport reports how evenly reviews were spread within each team, or
+// within the given one, over the window widened to whole days. The window
+// defaults to the last 30 days.
+func (s *service) GetFairnessReport(ctx context.Context, teamName string, window domain.StatsWindow, giniThreshold float64) ([]domain.TeamFairness, error) {
+	if err := validateWindow(window); err != nil {
+		return nil, err
+	}
+	if giniThreshold < 0 || giniThreshold > 1 {
+		return nil, fmt.Errorf("%w: gini threshold must be between 0 and 1", domain.ErrInvalidInput)
+	}
+
+	to := time.Now()
+	if window.To != nil {
+		to = *window.To
+	}
+	from := to.Add(-defaultFairnessWindow)
+	if window.From != nil {
+		from = *window.From
+	}
+	if !to.After(from) {
+		return nil, fmt.Errorf("%w: window must end after it starts", domain.ErrInvalidInput)
+	}
+	window = domain.StatsWindow{From: &from, To: &to}.WholeDays()
+	from, to = *window.From, *window.To
+
+	if teamName != "" {
+		var err error
+		if teamName, err = s.resolveTeamName(ctx, teamName); err != nil {
+			return nil, err
+		}
+		if _, err := s.teamRepo.GetTeamInfo(ctx, teamName); err != nil {
+			return nil, err
+		}
+	}
+
+	loads, err := s.statsRepo.MemberLoads(ctx, teamName, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.TeamFairness, 0, len(loads))
+	for team, members := range loads {
+		result = append(result, domain.NewTeamFairness(team, members, giniThreshold))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].TeamName < result[j].TeamName })
+	return result, nil
+}
+
+// RefreshStatsRollups brings the daily stats rollups up to date. It is meant to
+// be called periodically and returns the number of days recomputed.
+func (s *service) RefreshStatsRollups(ctx context.Context) (int, error) {
+	return s.statsRepo.RefreshRollups(ctx, time.Now())
+}
+
+// RebuildStatsRollups recomputes the rollups of the whole UTC days covering [from, to).
+func (s *service) RebuildStatsRollups(ctx context.Context, from, to time.Time) error {
+	window := domain.StatsWindow{From: &from, To: &to}
+	if err := validateWindow(window); err != nil {
+		return err
+	}
+	window = window.WholeDays()
+	return s.statsRepo.RebuildRollups(ctx, *window.From, *window.To)
+}
+
+func validateWindow(window domain.StatsWindow) error {
+	if window.From != nil && window.To != nil && !window.To.After(*window.From) {
+		return fmt.Errorf("%w: window must end after it starts", domain.ErrInvalidInput)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"crypto/rand"
+	"fmt"
+	mrand "math/rand/v2"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// WebhookSender sends a claimed delivery. It returns the response status, or 0
+// when no response was received, and an error unless the status is 2xx.
+type WebhookSender interface {
+	Send(ctx context.Context, d domain.DueDelivery) (int, error)
+}
+
+const (
+	webhookBatchSize = 50
+	// webhookLease must outlast a send so that a delivery is not claimed twice.
+	webhookLease       = time.Minute
+	webhookMaxAttempts = 10
+	webhookBaseBackoff = 30 * time.Second
+	webhookMaxBackoff  = 2 * time.Hour
+)
+
+// CreateWebhookSubscription registers an endpoint for the listed events. A
+// secret is generated unless one is given; it is only returned here.
+// Subscriptions receive events of all teams, so they are managed by admins.
+// URLs that name a non-public address are refused here; names that resolve to
+// one are refused by the sender when it connects.
+func (s *service) CreateWebhookSubscription(ctx context.Context, actor domain.Actor, sub domain.WebhookSubscription) (domain.WebhookSubscription, error) {
+	if err := requireAdmin(actor); err != nil {
+		return domain.WebhookSubscription{}, err
+	}
+	u, err := url.Parse(sub.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return domain.WebhookSubscription{}, fmt.Errorf("%w: url must be an absolute http or https URL", domain.ErrInvalidInput)
+	}
+	if !publicHost(u.Hostname()) {
+		return domain.WebhookSubscription{}, fmt.Errorf("%w: url must point to a public address", domain.ErrInvalidInput)
+	}
+	if len(sub.Events) == 0 {
+		return domain.WebhookSubscription{}, fmt.Errorf("%w: at least one event is required", domain.ErrInvalidInput)
+	}
+	for _, e := range sub.Events {
+		if !e.Valid() {
+			return domain.WebhookSubscription{}, fmt.Errorf("%w: unknown event %q", domain.ErrInvalidInput, e)
+		}
+	}
+	sub.Events = slices.Compact(slices.Sorted(slices.Values(sub.Events)))
+
+	if sub.Secret == "" {
+		sub.Secret = rand.Text()
+	}
+	return s.webhookRepo.CreateSubscription(ctx, sub)
+}
+
+// publicHost reports whether a URL host may be a public address. Names other
+// than localhost are let through, as they are only resolved when connecting.
+func publicHost(host string) bool {
+	if ip, err := netip.ParseAddr(host); err == nil {
+		return domain.PublicAddress(ip)
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	return host != "localhost" && !strings.HasSuffix(host, ".localhost")
+}
+
+func (s *service) ListWebhookSubscriptions(ctx context.Context, actor domain.Actor) ([]domain.WebhookSubscription, error) {
+	if err := requireAdmin(actor); err != nil {
+		return nil, err
+	}
+	return s.webhookRepo.ListSubscriptions(ctx)
+}
+
+func (s *service) DeleteWebhookSubscription(ctx context.Context, actor domain.Actor, id int64) error {
+	if err := requireAdmin(actor); err != nil {
+		return err
+	}
+	return s.webhookRepo.DeleteSubscription(ctx, id)
+}
+
+func (s *service) ListWebhookDeliveries(ctx context.Context, actor domain.Actor, filter domain.DeliveryFilter, page domain.PageRequest) ([]domain.WebhookDelivery, string, error) {
+	if err := requireAdmin(actor); err != nil {
+		return nil, "", err
+	}
+	if filter.Status != nil && !filter.Status.Valid() {
+		return nil, "", fmt.Errorf("%w: unknown delivery status %q", domain.ErrInvalidInput, *filter.Status)
+	}
+	return s.webhookRepo.ListDeliveries(ctx, filter, page)
+}
+
+// RedeliverWebhook sends the payload of a delivery once more as a new delivery
+// with a fresh set of attempts.
+func (s *service) RedeliverWebhook(ctx context.Context, actor domain.Actor, deliveryID int64) (domain.WebhookDelivery, error) {
+	if err := requireAdmin(actor); err != nil {
+		return domain.WebhookDelivery{}, err
+	}
+	return s.webhookRepo.Redeliver(ctx, deliveryID)
+}
+
+// DeliverWebhooks sends the deliveries that are due, a batch at a time, and
+// returns how many of them succeeded. Failed attempts are retried with
+// exponential backoff until webhookMaxAttempts is reached. It is meant to be
+// called periodically.
+func (s *service) DeliverWebhooks(ctx context.Context) (int, error) {
+	if s.cfg.WebhookSender == nil {
+		return 0, nil
+	}
+
+	succeeded := 0
+	for {
+		due, err := s.webhookRepo.ClaimDue(ctx, webhookBatchSize, webhookLease)
+		if err != nil || len(due) == 0 {
+			return succeeded, err
+		}
+
+		// A batch is sent concurrently so that one slow subscriber does not
+		// hold the others up past the lease.
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var firstErr error
+		for _, d := range due {
+			wg.Go(func() {
+				ok, err := s.deliver(ctx, d)
+				mu.Lock()
+				defer mu.Unlock()
+				if ok {
+					succeeded++
+				}
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+			})
+		}
+		wg.Wait()
+
+		if firstErr != nil || len(due) < webhookBatchSize {
+			return succeeded, firstErr
+		}
+	}
+}
+
+// deliver sends a claimed delivery once and records the outcome.
+func (s *service) deliver(ctx context.Context, d domain.DueDelivery) (bool, error) {
+	status, sendErr := s.cfg.WebhookSender.Send(ctx, d)
+	if ctx.Err() != nil {
+		// Shutting down; the lease runs out and the delivery is retried.
+		return false, nil
+	}
+
+	attempt := domain.DeliveryAttempt{At: time.Now(), Succeeded: sendErr == nil}
+	if status != 0 {
+		attempt.ResponseStatus = &status
+	}
+	outcome := WebhookSucceeded
+	if sendErr != nil {
+		attempt.Error = sendErr.Error()
+		outcome = WebhookFailed
+		if n := d.Delivery.Attempts + 1; n < webhookMaxAttempts {
+			next := attempt.At.Add(webhookBackoff(n))
+			attempt.NextAttemptAt = &next
+			outcome = WebhookRetried
+		}
+	}
+
+	if err := s.webhookRepo.RecordAttempt(ctx, d.Delivery.ID, attempt); err != nil {
+		return false, err
+	}
+	s.cfg.Recorder.WebhookDelivered(outcome)
+	return sendErr == nil, nil
+}
+
+// webhookBackoff is the wait after the given number of failed attempts: it
+// doubles from webhookBaseBackoff up to webhookMaxBackoff, plus up to a fifth
+// of jitter so that retries to a recovered subscriber are spread out.
+func webhookBackoff(attempts int) time.Duration {
+	d := webhookMaxBackoff
+	if shift := attempts - 1; shift < 16 {
+		d = min(webhookBaseBackoff<<shift, webhookMaxBackoff)
+	}
+	return d + mrand.N(d/5)
+}
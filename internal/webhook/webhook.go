@@ -0,0 +1,107 @@
+// Package webhook sends signed event deliveries to subscribers' endpoints.
+package webhook
+
+import (
+	"avito-test-task/internal/domain"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	// EventHeader names the event type of a delivery.
+	EventHeader = "X-Reviewer-Event"
+	// DeliveryHeader carries the delivery id; redeliveries get a new one.
+	DeliveryHeader = "X-Reviewer-Delivery"
+	// TimestampHeader carries the Unix time the request was signed at.
+	TimestampHeader = "X-Reviewer-Timestamp"
+	// SignatureHeader carries "sha256=" and the hex HMAC-SHA256 of the
+	// timestamp, a dot and the body, keyed with the subscription secret.
+	SignatureHeader = "X-Reviewer-Signature-256"
+)
+
+// Sign returns the SignatureHeader value for a body signed at timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sender posts deliveries over HTTP. Redirects are not followed, so a
+// subscriber cannot bounce signed payloads elsewhere, and connections are only
+// made to public addresses, so that subscriptions cannot reach the service's
+// own network. The address is checked when dialing, after DNS resolution, so a
+// name that resolves to an internal address is refused as well.
+type Sender struct {
+	client *http.Client
+}
+
+func NewSender(timeout time.Duration) *Sender {
+	dialer := &net.Dialer{Timeout: timeout, Control: dialPublicOnly}
+	return &Sender{client: &http.Client{
+		Timeout: timeout,
+		// No proxy: the address checked on dialing must be the subscriber's.
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// dialPublicOnly refuses connections to addresses that are not public.
+func dialPublicOnly(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !domain.PublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("refusing to connect to non-public address %s", addrPort.Addr())
+	}
+	return nil
+}
+
+// Send posts the delivery and returns the response status. Any status outside
+// 2xx is reported as an error along with the status.
+func (s *Sender) Send(ctx context.Context, d domain.DueDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "reviewer-service-webhook")
+	req.Header.Set(EventHeader, string(d.Delivery.Event))
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(d.Delivery.ID, 10))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(now, 10))
+	req.Header.Set(SignatureHeader, Sign(d.Secret, now, d.Delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("subscriber responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,35 @@
+package webhook
+
+import (
+	"avito-test-task/internal/domain"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSendRefusesNonPublicAddresses(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer srv.Close()
+
+	// The test server listens on loopback; "localhost" checks that names are
+	// refused after they resolve.
+	urls := []string{srv.URL, strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)}
+	for _, url := range urls {
+		t.Run(url, func(t *testing.T) {
+			d := domain.DueDelivery{URL: url, Secret: "s3cret", Delivery: domain.WebhookDelivery{ID: 1, Payload: []byte(`{}`)}}
+			status, err := NewSender(time.Second).Send(context.Background(), d)
+			if err == nil || !strings.Contains(err.Error(), "non-public address") {
+				t.Errorf("Send = %d, %v; want refusal", status, err)
+			}
+		})
+	}
+	if called {
+		t.Error("subscriber on loopback was reached")
+	}
+}
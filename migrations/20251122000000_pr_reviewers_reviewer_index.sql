@@ -0,0 +1,7 @@
+-- +goose Up
+CREATE INDEX idx_pr_reviewers_reviewer ON pr_reviewers(reviewer_id);
+CREATE INDEX idx_pr_status ON pull_requests(status);
+
+-- +goose Down
+DROP INDEX idx_pr_status;
+DROP INDEX idx_pr_reviewers_reviewer;
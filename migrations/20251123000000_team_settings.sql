@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE teams ADD COLUMN reassign_on_deactivate BOOLEAN NOT NULL DEFAULT false;
+
+-- +goose Down
+ALTER TABLE teams DROP COLUMN reassign_on_deactivate;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE teams ADD COLUMN archived_at TIMESTAMP WITH TIME ZONE;
+
+-- +goose Down
+ALTER TABLE teams DROP COLUMN archived_at;
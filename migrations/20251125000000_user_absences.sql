@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE user_absences (
+                               id BIGSERIAL PRIMARY KEY,
+                               user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+                               starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+                               ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+                               reason TEXT NOT NULL DEFAULT '',
+                               reassign_on_start BOOLEAN NOT NULL DEFAULT false,
+                               reassigned_at TIMESTAMP WITH TIME ZONE,
+                               CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_user_absences_user ON user_absences(user_id, ends_at);
+CREATE INDEX idx_user_absences_pending ON user_absences(starts_at)
+    WHERE reassign_on_start AND reassigned_at IS NULL;
+
+-- +goose Down
+DROP TABLE user_absences;
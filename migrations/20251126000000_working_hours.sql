@@ -0,0 +1,29 @@
+-- +goose Up
+ALTER TABLE users
+    ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    ADD COLUMN work_start_minute SMALLINT NOT NULL DEFAULT 540,
+    ADD COLUMN work_end_minute SMALLINT NOT NULL DEFAULT 1080,
+    ADD CHECK (work_start_minute >= 0 AND work_end_minute <= 1440 AND work_start_minute < work_end_minute);
+
+ALTER TABLE teams
+    ADD COLUMN reviewer_selection VARCHAR(32) NOT NULL DEFAULT 'random',
+    ADD COLUMN review_sla_hours INTEGER CHECK (review_sla_hours > 0);
+
+ALTER TABLE pr_reviewers ADD COLUMN assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+UPDATE pr_reviewers rev
+SET assigned_at = pr.created_at
+FROM pull_requests pr
+WHERE pr.id = rev.pull_request_id AND pr.created_at IS NOT NULL;
+
+-- +goose Down
+ALTER TABLE pr_reviewers DROP COLUMN assigned_at;
+
+ALTER TABLE teams
+    DROP COLUMN review_sla_hours,
+    DROP COLUMN reviewer_selection;
+
+ALTER TABLE users
+    DROP COLUMN work_end_minute,
+    DROP COLUMN work_start_minute,
+    DROP COLUMN timezone;
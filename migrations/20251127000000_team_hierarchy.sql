@@ -0,0 +1,39 @@
+-- +goose Up
+ALTER TABLE teams
+    ADD COLUMN parent_name VARCHAR(255) REFERENCES teams(name),
+    ADD CHECK (parent_name <> name);
+
+CREATE INDEX idx_teams_parent ON teams(parent_name);
+
+-- Settings become per-team overrides: NULL means "inherit from the parent team".
+ALTER TABLE teams
+    ALTER COLUMN reassign_on_deactivate DROP NOT NULL,
+    ALTER COLUMN reassign_on_deactivate DROP DEFAULT,
+    ALTER COLUMN reviewer_selection DROP NOT NULL,
+    ALTER COLUMN reviewer_selection DROP DEFAULT,
+    DROP CONSTRAINT teams_review_sla_hours_check,
+    ADD CONSTRAINT teams_review_sla_hours_check CHECK (review_sla_hours >= 0),
+    ADD COLUMN reviewer_count SMALLINT CHECK (reviewer_count BETWEEN 1 AND 10),
+    ADD COLUMN escalate_to_parent BOOLEAN;
+
+UPDATE teams SET reassign_on_deactivate = NULL WHERE NOT reassign_on_deactivate;
+UPDATE teams SET reviewer_selection = NULL WHERE reviewer_selection = 'random';
+
+-- +goose Down
+UPDATE teams SET reassign_on_deactivate = false WHERE reassign_on_deactivate IS NULL;
+UPDATE teams SET reviewer_selection = 'random' WHERE reviewer_selection IS NULL;
+UPDATE teams SET review_sla_hours = NULL WHERE review_sla_hours = 0;
+
+ALTER TABLE teams
+    DROP COLUMN escalate_to_parent,
+    DROP COLUMN reviewer_count,
+    DROP CONSTRAINT teams_review_sla_hours_check,
+    ADD CONSTRAINT teams_review_sla_hours_check CHECK (review_sla_hours > 0),
+    ALTER COLUMN reviewer_selection SET DEFAULT 'random',
+    ALTER COLUMN reviewer_selection SET NOT NULL,
+    ALTER COLUMN reassign_on_deactivate SET DEFAULT false,
+    ALTER COLUMN reassign_on_deactivate SET NOT NULL;
+
+DROP INDEX idx_teams_parent;
+
+ALTER TABLE teams DROP COLUMN parent_name;
@@ -0,0 +1,16 @@
+-- +goose Up
+ALTER TABLE users
+    ADD COLUMN team_role VARCHAR(16) NOT NULL DEFAULT 'MEMBER'
+        CHECK (team_role IN ('MEMBER', 'LEAD', 'MAINTAINER'));
+
+CREATE INDEX idx_users_team_role ON users(team_name) WHERE team_role <> 'MEMBER';
+
+-- Set once an overdue review has been escalated to a team lead.
+ALTER TABLE pr_reviewers ADD COLUMN escalated_at TIMESTAMP WITH TIME ZONE;
+
+-- +goose Down
+ALTER TABLE pr_reviewers DROP COLUMN escalated_at;
+
+DROP INDEX idx_users_team_role;
+
+ALTER TABLE users DROP COLUMN team_role;
@@ -0,0 +1,45 @@
+-- +goose Up
+-- users.team_name stays as the user's primary team; every team a user belongs
+-- to, the primary one included, has a row here.
+CREATE TABLE team_members (
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(name),
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(16) NOT NULL DEFAULT 'MEMBER' CHECK (role IN ('MEMBER', 'LEAD', 'MAINTAINER')),
+    joined_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team_name, user_id)
+);
+
+CREATE INDEX idx_team_members_user ON team_members(user_id);
+
+INSERT INTO team_members (team_name, user_id, role)
+SELECT team_name, id, team_role FROM users;
+
+DROP INDEX idx_users_team_role;
+ALTER TABLE users DROP COLUMN team_role;
+
+-- The team that owns a pull request; reviewers are picked among its members.
+ALTER TABLE pull_requests ADD COLUMN team_name VARCHAR(255) REFERENCES teams(name);
+
+UPDATE pull_requests pr SET team_name = u.team_name
+FROM users u
+WHERE u.id = pr.author_id;
+
+ALTER TABLE pull_requests ALTER COLUMN team_name SET NOT NULL;
+
+CREATE INDEX idx_pr_team ON pull_requests(team_name);
+
+-- +goose Down
+DROP INDEX idx_pr_team;
+ALTER TABLE pull_requests DROP COLUMN team_name;
+
+ALTER TABLE users
+    ADD COLUMN team_role VARCHAR(16) NOT NULL DEFAULT 'MEMBER'
+        CHECK (team_role IN ('MEMBER', 'LEAD', 'MAINTAINER'));
+
+UPDATE users u SET team_role = tm.role
+FROM team_members tm
+WHERE tm.user_id = u.id AND tm.team_name = u.team_name;
+
+CREATE INDEX idx_users_team_role ON users(team_name) WHERE team_role <> 'MEMBER';
+
+DROP TABLE team_members;
@@ -0,0 +1,44 @@
+-- +goose Up
+ALTER TABLE users
+    DROP CONSTRAINT users_team_name_fkey,
+    ADD CONSTRAINT users_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE;
+
+ALTER TABLE teams
+    DROP CONSTRAINT teams_parent_name_fkey,
+    ADD CONSTRAINT teams_parent_name_fkey FOREIGN KEY (parent_name) REFERENCES teams(name) ON UPDATE CASCADE;
+
+ALTER TABLE team_members
+    DROP CONSTRAINT team_members_team_name_fkey,
+    ADD CONSTRAINT team_members_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE;
+
+ALTER TABLE pull_requests
+    DROP CONSTRAINT pull_requests_team_name_fkey,
+    ADD CONSTRAINT pull_requests_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE;
+
+-- Previous names of renamed teams, resolved to the current name until they expire.
+CREATE TABLE team_aliases (
+    alias VARCHAR(255) PRIMARY KEY,
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_team_aliases_team ON team_aliases(team_name);
+
+-- +goose Down
+DROP TABLE team_aliases;
+
+ALTER TABLE pull_requests
+    DROP CONSTRAINT pull_requests_team_name_fkey,
+    ADD CONSTRAINT pull_requests_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name);
+
+ALTER TABLE team_members
+    DROP CONSTRAINT team_members_team_name_fkey,
+    ADD CONSTRAINT team_members_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name);
+
+ALTER TABLE teams
+    DROP CONSTRAINT teams_parent_name_fkey,
+    ADD CONSTRAINT teams_parent_name_fkey FOREIGN KEY (parent_name) REFERENCES teams(name);
+
+ALTER TABLE users
+    DROP CONSTRAINT users_team_name_fkey,
+    ADD CONSTRAINT users_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name);
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Changes made to reviewer slots by the service itself rather than on request.
+CREATE TABLE review_history (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES users(id),
+    event VARCHAR(32) NOT NULL CHECK (event IN ('BACKFILLED')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_review_history_pr ON review_history(pull_request_id, created_at);
+
+-- +goose Down
+DROP TABLE review_history;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Reviewer slots given up on reassignment; backfill tops the PR up to the team's
+-- reviewer count minus these.
+ALTER TABLE pull_requests ADD COLUMN removed_slots INT NOT NULL DEFAULT 0;
+
+ALTER TABLE review_history
+    DROP CONSTRAINT review_history_event_check,
+    ADD CONSTRAINT review_history_event_check CHECK (event IN ('BACKFILLED', 'REMOVED', 'PARKED'));
+
+-- +goose Down
+DELETE FROM review_history WHERE event <> 'BACKFILLED';
+
+ALTER TABLE review_history
+    DROP CONSTRAINT review_history_event_check,
+    ADD CONSTRAINT review_history_event_check CHECK (event IN ('BACKFILLED'));
+
+ALTER TABLE pull_requests DROP COLUMN removed_slots;
@@ -0,0 +1,36 @@
+-- +goose Up
+-- review_history becomes the log of every reviewer assignment. REASSIGNED rows
+-- keep the reviewer the slot was taken from.
+ALTER TABLE review_history ADD COLUMN previous_reviewer_id VARCHAR(255) REFERENCES users(id);
+
+ALTER TABLE review_history
+    DROP CONSTRAINT review_history_event_check,
+    ADD CONSTRAINT review_history_event_check
+        CHECK (event IN ('ASSIGNED', 'BACKFILLED', 'ESCALATED', 'REASSIGNED', 'REMOVED', 'PARKED'));
+
+-- Current assignments made before the log existed. Earlier reassignments are lost.
+INSERT INTO review_history (pull_request_id, reviewer_id, event, created_at)
+SELECT rev.pull_request_id, rev.reviewer_id, 'ASSIGNED', rev.assigned_at
+FROM pr_reviewers rev
+WHERE NOT EXISTS (
+    SELECT 1 FROM review_history h
+    WHERE h.pull_request_id = rev.pull_request_id AND h.reviewer_id = rev.reviewer_id
+);
+
+CREATE INDEX idx_review_history_created ON review_history(created_at);
+CREATE INDEX idx_review_history_previous ON review_history(previous_reviewer_id, created_at)
+    WHERE previous_reviewer_id IS NOT NULL;
+CREATE INDEX idx_pr_merged_at ON pull_requests(merged_at) WHERE merged_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_pr_merged_at;
+DROP INDEX idx_review_history_previous;
+DROP INDEX idx_review_history_created;
+
+DELETE FROM review_history WHERE event NOT IN ('BACKFILLED', 'REMOVED', 'PARKED');
+
+ALTER TABLE review_history
+    DROP CONSTRAINT review_history_event_check,
+    ADD CONSTRAINT review_history_event_check CHECK (event IN ('BACKFILLED', 'REMOVED', 'PARKED'));
+
+ALTER TABLE review_history DROP COLUMN previous_reviewer_id;
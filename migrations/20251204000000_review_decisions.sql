@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE pr_reviewers
+    ADD COLUMN decision VARCHAR(32) CHECK (decision IN ('APPROVED', 'CHANGES_REQUESTED')),
+    ADD COLUMN decided_at TIMESTAMP WITH TIME ZONE;
+
+-- Kept on the pull request because reviewer slots may be reassigned afterwards.
+ALTER TABLE pull_requests ADD COLUMN first_decision_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_pr_team_created ON pull_requests(team_name, created_at);
+CREATE INDEX idx_pr_team_merged ON pull_requests(team_name, merged_at) WHERE merged_at IS NOT NULL;
+CREATE INDEX idx_pr_team_first_decision ON pull_requests(team_name, first_decision_at)
+    WHERE first_decision_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_pr_team_first_decision;
+DROP INDEX idx_pr_team_merged;
+DROP INDEX idx_pr_team_created;
+
+ALTER TABLE pull_requests DROP COLUMN first_decision_at;
+
+ALTER TABLE pr_reviewers
+    DROP COLUMN decided_at,
+    DROP COLUMN decision;
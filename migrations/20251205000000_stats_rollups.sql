@@ -0,0 +1,112 @@
+-- +goose Up
+-- Daily rollups behind the stats endpoints. Every fact is attributed to the UTC
+-- day it happened on (assignment, reassignment, merge), so later changes land in
+-- later days instead of rewriting aggregated ones.
+CREATE TABLE review_stats_user_daily (
+    day DATE NOT NULL,
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    assignments INT NOT NULL DEFAULT 0,
+    reassigned_in INT NOT NULL DEFAULT 0,
+    reassigned_away INT NOT NULL DEFAULT 0,
+    merged_reviewed INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (day, team_name, user_id)
+);
+
+CREATE INDEX idx_review_stats_user_daily_team ON review_stats_user_daily(team_name, day);
+
+-- Rollups are rebuilt by day ranges.
+CREATE INDEX idx_pr_created ON pull_requests(created_at);
+
+CREATE TABLE review_stats_team_daily (
+    day DATE NOT NULL,
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    prs_created INT NOT NULL DEFAULT 0,
+    prs_merged INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (team_name, day)
+);
+
+-- Days before sealed_through are only recomputed when marked dirty.
+CREATE TABLE stats_rollup_state (
+    id BOOLEAN PRIMARY KEY DEFAULT true CHECK (id),
+    sealed_through DATE
+);
+
+INSERT INTO stats_rollup_state (id) VALUES (true);
+
+CREATE TABLE stats_dirty_days (
+    day DATE PRIMARY KEY
+);
+
+-- +goose StatementBegin
+CREATE FUNCTION mark_stats_day_dirty(ts TIMESTAMP WITH TIME ZONE) RETURNS void AS $$
+BEGIN
+    IF ts IS NOT NULL AND (ts AT TIME ZONE 'UTC')::date < (NOW() AT TIME ZONE 'UTC')::date THEN
+        INSERT INTO stats_dirty_days (day) VALUES ((ts AT TIME ZONE 'UTC')::date) ON CONFLICT DO NOTHING;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION review_history_mark_dirty() RETURNS trigger AS $$
+BEGIN
+    PERFORM mark_stats_day_dirty(NEW.created_at);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION pull_requests_mark_dirty() RETURNS trigger AS $$
+BEGIN
+    PERFORM mark_stats_day_dirty(NEW.created_at);
+    PERFORM mark_stats_day_dirty(NEW.merged_at);
+    IF TG_OP = 'UPDATE' THEN
+        PERFORM mark_stats_day_dirty(OLD.merged_at);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION pr_reviewers_mark_dirty() RETURNS trigger AS $$
+DECLARE
+    pr_id VARCHAR(255);
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        pr_id := OLD.pull_request_id;
+    ELSE
+        pr_id := NEW.pull_request_id;
+    END IF;
+    PERFORM mark_stats_day_dirty(merged_at) FROM pull_requests WHERE id = pr_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER review_history_stats_dirty AFTER INSERT ON review_history
+    FOR EACH ROW EXECUTE FUNCTION review_history_mark_dirty();
+
+CREATE TRIGGER pull_requests_stats_dirty AFTER INSERT OR UPDATE OF created_at, merged_at ON pull_requests
+    FOR EACH ROW EXECUTE FUNCTION pull_requests_mark_dirty();
+
+CREATE TRIGGER pr_reviewers_stats_dirty AFTER INSERT OR UPDATE OR DELETE ON pr_reviewers
+    FOR EACH ROW EXECUTE FUNCTION pr_reviewers_mark_dirty();
+
+-- +goose Down
+DROP TRIGGER pr_reviewers_stats_dirty ON pr_reviewers;
+DROP TRIGGER pull_requests_stats_dirty ON pull_requests;
+DROP TRIGGER review_history_stats_dirty ON review_history;
+
+DROP FUNCTION pr_reviewers_mark_dirty();
+DROP FUNCTION pull_requests_mark_dirty();
+DROP FUNCTION review_history_mark_dirty();
+DROP FUNCTION mark_stats_day_dirty(TIMESTAMP WITH TIME ZONE);
+
+DROP TABLE stats_dirty_days;
+DROP TABLE stats_rollup_state;
+DROP TABLE review_stats_team_daily;
+DROP INDEX idx_pr_created;
+DROP TABLE review_stats_user_daily;
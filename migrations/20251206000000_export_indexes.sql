@@ -0,0 +1,9 @@
+-- +goose Up
+-- Keyset paging of the analytics export walks these in (time, key) order.
+CREATE INDEX idx_pr_reviewers_assigned ON pr_reviewers(assigned_at, pull_request_id, reviewer_id);
+CREATE INDEX idx_pr_reviewers_decided ON pr_reviewers(decided_at, pull_request_id, reviewer_id)
+    WHERE decided_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_pr_reviewers_decided;
+DROP INDEX idx_pr_reviewers_assigned;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- Pull requests closed without merging on the code host.
+ALTER TABLE pull_requests ADD COLUMN closed_at TIMESTAMP WITH TIME ZONE;
+
+-- Code host accounts and teams mapped onto users and teams of the service.
+-- Logins are stored lowercased since code hosts match them case-insensitively.
+CREATE TABLE external_users (
+    provider VARCHAR(32) NOT NULL,
+    login VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (provider, login)
+);
+
+CREATE TABLE external_teams (
+    provider VARCHAR(32) NOT NULL,
+    external_team VARCHAR(255) NOT NULL,
+    team_name VARCHAR(255) NOT NULL REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY (provider, external_team)
+);
+
+-- +goose Down
+DROP TABLE external_teams;
+DROP TABLE external_users;
+
+ALTER TABLE pull_requests DROP COLUMN closed_at;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- Domain events, written in the transaction of the change they describe and
+-- relayed to sinks afterwards. Rows are numbered in commit order per key.
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL UNIQUE,
+    event VARCHAR(64) NOT NULL,
+    event_key VARCHAR(255) NOT NULL,
+    payload BYTEA NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_outbox_unpublished ON outbox(id) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_published ON outbox(published_at) WHERE published_at IS NOT NULL;
+
+-- The relay publishes at least once; an event relayed twice must not be
+-- delivered to a subscription twice.
+CREATE UNIQUE INDEX idx_webhook_deliveries_event ON webhook_deliveries(subscription_id, event_id)
+    WHERE redelivery_of IS NULL;
+
+-- +goose Down
+DROP INDEX idx_webhook_deliveries_event;
+DROP TABLE outbox;
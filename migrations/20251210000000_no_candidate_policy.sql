@@ -0,0 +1,8 @@
+-- +goose Up
+-- What bulk reassignment does with a slot nobody can take over: park it for
+-- backfill or remove it. NULL inherits from the parent team.
+ALTER TABLE teams
+    ADD COLUMN no_candidate_policy VARCHAR(16) CHECK (no_candidate_policy IN ('remove', 'park'));
+
+-- +goose Down
+ALTER TABLE teams DROP COLUMN no_candidate_policy;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- Review decisions a pull request needs before it can be merged. NULL inherits
+-- from the parent team.
+ALTER TABLE teams
+    ADD COLUMN merge_policy VARCHAR(16) CHECK (merge_policy IN ('any', 'approved', 'all_approved'));
+
+-- +goose Down
+ALTER TABLE teams DROP COLUMN merge_policy;
@@ -0,0 +1,37 @@
+-- +goose Up
+-- Periods a user was deactivated, kept by a trigger whatever flips is_active.
+-- The fairness report weights review load by the time a member was active.
+-- Users inactive before this migration count as inactive since ever.
+CREATE TABLE user_inactivity (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX idx_user_inactivity_open ON user_inactivity(user_id) WHERE ends_at IS NULL;
+CREATE INDEX idx_user_inactivity_user ON user_inactivity(user_id, starts_at);
+
+INSERT INTO user_inactivity (user_id, starts_at)
+SELECT id, '-infinity' FROM users WHERE NOT is_active;
+
+-- +goose StatementBegin
+CREATE FUNCTION users_record_inactivity() RETURNS trigger AS $$
+BEGIN
+    IF NOT NEW.is_active AND (TG_OP = 'INSERT' OR OLD.is_active) THEN
+        INSERT INTO user_inactivity (user_id, starts_at) VALUES (NEW.id, NOW());
+    ELSIF TG_OP = 'UPDATE' AND NEW.is_active AND NOT OLD.is_active THEN
+        UPDATE user_inactivity SET ends_at = NOW() WHERE user_id = NEW.id AND ends_at IS NULL;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER users_inactivity AFTER INSERT OR UPDATE OF is_active ON users
+    FOR EACH ROW EXECUTE FUNCTION users_record_inactivity();
+
+-- +goose Down
+DROP TRIGGER users_inactivity ON users;
+DROP FUNCTION users_record_inactivity();
+DROP TABLE user_inactivity;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Deliveries carry the key of their event, so that a subscription gets the
+-- events of a pull request or user one at a time, in order. Earlier deliveries
+-- take the key from their payload.
+ALTER TABLE webhook_deliveries ADD COLUMN event_key VARCHAR(255);
+
+UPDATE webhook_deliveries
+SET event_key = COALESCE(
+    convert_from(payload, 'UTF8')::jsonb #>> '{data,pull_request_id}',
+    convert_from(payload, 'UTF8')::jsonb #>> '{data,user_id}',
+    event_id);
+
+ALTER TABLE webhook_deliveries ALTER COLUMN event_key SET NOT NULL;
+
+CREATE INDEX idx_webhook_deliveries_key ON webhook_deliveries(subscription_id, event_key, id)
+    WHERE status = 'PENDING';
+
+-- +goose Down
+DROP INDEX idx_webhook_deliveries_key;
+ALTER TABLE webhook_deliveries DROP COLUMN event_key;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- A pull request keeps the name of its team as a snapshot, so that teams with
+-- merged or closed pull requests can be deleted. Renames are applied to it by
+-- the application, and pull requests are only opened against existing teams.
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_team_name_fkey;
+
+-- +goose Down
+ALTER TABLE pull_requests
+    ADD CONSTRAINT pull_requests_team_name_fkey FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE NOT VALID;
@@ -17,51 +17,345 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	FORBIDDEN      ErrorResponseErrorCode = "FORBIDDEN"
+	HASOPENREVIEWS ErrorResponseErrorCode = "HAS_OPEN_REVIEWS"
+	INVALIDINPUT   ErrorResponseErrorCode = "INVALID_INPUT"
+	MEMBERCONFLICT ErrorResponseErrorCode = "MEMBER_CONFLICT"
+	MERGEBLOCKED   ErrorResponseErrorCode = "MERGE_BLOCKED"
+	NOCANDIDATE    ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED    ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND       ErrorResponseErrorCode = "NOT_FOUND"
+	PRCLOSED       ErrorResponseErrorCode = "PR_CLOSED"
+	PREXISTS       ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED       ErrorResponseErrorCode = "PR_MERGED"
+	TEAMARCHIVED   ErrorResponseErrorCode = "TEAM_ARCHIVED"
+	TEAMEXISTS     ErrorResponseErrorCode = "TEAM_EXISTS"
+	TEAMNOTEMPTY   ErrorResponseErrorCode = "TEAM_NOT_EMPTY"
+	UNAUTHORIZED   ErrorResponseErrorCode = "UNAUTHORIZED"
+)
+
+// Defines values for MemberConflictReason.
+const (
+	DUPLICATE MemberConflictReason = "DUPLICATE"
+	OTHERTEAM MemberConflictReason = "OTHER_TEAM"
+)
+
+// Defines values for Provider.
+const (
+	Github Provider = "github"
+	Gitlab Provider = "gitlab"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewReassignmentOutcome.
+const (
+	PARKED   ReviewReassignmentOutcome = "PARKED"
+	REMOVED  ReviewReassignmentOutcome = "REMOVED"
+	REPLACED ReviewReassignmentOutcome = "REPLACED"
+)
+
+// Defines values for TeamRole.
+const (
+	LEAD       TeamRole = "LEAD"
+	MAINTAINER TeamRole = "MAINTAINER"
+	MEMBER     TeamRole = "MEMBER"
+)
+
+// Defines values for TeamSettingsMergePolicy.
+const (
+	TeamSettingsMergePolicyAllApproved TeamSettingsMergePolicy = "all_approved"
+	TeamSettingsMergePolicyAny         TeamSettingsMergePolicy = "any"
+	TeamSettingsMergePolicyApproved    TeamSettingsMergePolicy = "approved"
+)
+
+// Defines values for TeamSettingsNoCandidatePolicy.
+const (
+	TeamSettingsNoCandidatePolicyPark   TeamSettingsNoCandidatePolicy = "park"
+	TeamSettingsNoCandidatePolicyRemove TeamSettingsNoCandidatePolicy = "remove"
+)
+
+// Defines values for TeamSettingsReviewerSelection.
+const (
+	TeamSettingsReviewerSelectionRandom       TeamSettingsReviewerSelection = "random"
+	TeamSettingsReviewerSelectionWorkingHours TeamSettingsReviewerSelection = "working_hours"
+)
+
+// Defines values for TeamSettingsOverridesMergePolicy.
+const (
+	TeamSettingsOverridesMergePolicyAllApproved TeamSettingsOverridesMergePolicy = "all_approved"
+	TeamSettingsOverridesMergePolicyAny         TeamSettingsOverridesMergePolicy = "any"
+	TeamSettingsOverridesMergePolicyApproved    TeamSettingsOverridesMergePolicy = "approved"
+)
+
+// Defines values for TeamSettingsOverridesNoCandidatePolicy.
+const (
+	TeamSettingsOverridesNoCandidatePolicyPark   TeamSettingsOverridesNoCandidatePolicy = "park"
+	TeamSettingsOverridesNoCandidatePolicyRemove TeamSettingsOverridesNoCandidatePolicy = "remove"
+)
+
+// Defines values for TeamSettingsOverridesReviewerSelection.
+const (
+	TeamSettingsOverridesReviewerSelectionRandom       TeamSettingsOverridesReviewerSelection = "random"
+	TeamSettingsOverridesReviewerSelectionWorkingHours TeamSettingsOverridesReviewerSelection = "working_hours"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryStatusSUCCEEDED WebhookDeliveryStatus = "SUCCEEDED"
+)
+
+// Defines values for WebhookEventType.
+const (
+	PrCreated          WebhookEventType = "pr.created"
+	PrMerged           WebhookEventType = "pr.merged"
+	ReviewerAssigned   WebhookEventType = "reviewer.assigned"
+	ReviewerReassigned WebhookEventType = "reviewer.reassigned"
+	UserDeactivated    WebhookEventType = "user.deactivated"
+)
+
+// Defines values for OrderQuery.
+const (
+	OrderQueryAsc  OrderQuery = "asc"
+	OrderQueryDesc OrderQuery = "desc"
+)
+
+// Defines values for GetExportDatasetParamsFormat.
+const (
+	Csv     GetExportDatasetParamsFormat = "csv"
+	Parquet GetExportDatasetParamsFormat = "parquet"
+)
+
+// Defines values for GetExportDatasetParamsDataset.
+const (
+	Assignments  GetExportDatasetParamsDataset = "assignments"
+	Decisions    GetExportDatasetParamsDataset = "decisions"
+	Events       GetExportDatasetParamsDataset = "events"
+	PullRequests GetExportDatasetParamsDataset = "pull_requests"
+)
+
+// Defines values for PostPullRequestDecideJSONBodyDecision.
+const (
+	APPROVED         PostPullRequestDecideJSONBodyDecision = "APPROVED"
+	CHANGESREQUESTED PostPullRequestDecideJSONBodyDecision = "CHANGES_REQUESTED"
+)
+
+// Defines values for PostPullRequestReassignJSONBodyOnNoCandidate.
+const (
+	PostPullRequestReassignJSONBodyOnNoCandidateFail   PostPullRequestReassignJSONBodyOnNoCandidate = "fail"
+	PostPullRequestReassignJSONBodyOnNoCandidatePark   PostPullRequestReassignJSONBodyOnNoCandidate = "park"
+	PostPullRequestReassignJSONBodyOnNoCandidateRemove PostPullRequestReassignJSONBodyOnNoCandidate = "remove"
+)
+
+// Defines values for GetSubscriptionsDeliveriesParamsStatus.
+const (
+	GetSubscriptionsDeliveriesParamsStatusFAILED    GetSubscriptionsDeliveriesParamsStatus = "FAILED"
+	GetSubscriptionsDeliveriesParamsStatusPENDING   GetSubscriptionsDeliveriesParamsStatus = "PENDING"
+	GetSubscriptionsDeliveriesParamsStatusSUCCEEDED GetSubscriptionsDeliveriesParamsStatus = "SUCCEEDED"
+)
+
+// Defines values for PostTeamAddJSONBodyConflictPolicy.
+const (
+	PostTeamAddJSONBodyConflictPolicyFail PostTeamAddJSONBodyConflictPolicy = "fail"
+	PostTeamAddJSONBodyConflictPolicyMove PostTeamAddJSONBodyConflictPolicy = "move"
+	PostTeamAddJSONBodyConflictPolicySkip PostTeamAddJSONBodyConflictPolicy = "skip"
+)
+
+// Defines values for GetTeamListParamsOrder.
+const (
+	GetTeamListParamsOrderAsc  GetTeamListParamsOrder = "asc"
+	GetTeamListParamsOrderDesc GetTeamListParamsOrder = "desc"
+)
+
+// Defines values for GetTeamListParamsSortBy.
+const (
+	Members GetTeamListParamsSortBy = "members"
+	Name    GetTeamListParamsSortBy = "name"
+	OpenPrs GetTeamListParamsSortBy = "open_prs"
+)
+
+// Defines values for PostTeamUpdateSettingsJSONBodyInherit.
+const (
+	EscalateToParent     PostTeamUpdateSettingsJSONBodyInherit = "escalate_to_parent"
+	MergePolicy          PostTeamUpdateSettingsJSONBodyInherit = "merge_policy"
+	NoCandidatePolicy    PostTeamUpdateSettingsJSONBodyInherit = "no_candidate_policy"
+	ReassignOnDeactivate PostTeamUpdateSettingsJSONBodyInherit = "reassign_on_deactivate"
+	ReviewSlaHours       PostTeamUpdateSettingsJSONBodyInherit = "review_sla_hours"
+	ReviewerCount        PostTeamUpdateSettingsJSONBodyInherit = "reviewer_count"
+	ReviewerSelection    PostTeamUpdateSettingsJSONBodyInherit = "reviewer_selection"
+)
+
+// Defines values for PostTeamUpdateSettingsJSONBodyMergePolicy.
+const (
+	AllApproved PostTeamUpdateSettingsJSONBodyMergePolicy = "all_approved"
+	Any         PostTeamUpdateSettingsJSONBodyMergePolicy = "any"
+	Approved    PostTeamUpdateSettingsJSONBodyMergePolicy = "approved"
+)
+
+// Defines values for PostTeamUpdateSettingsJSONBodyNoCandidatePolicy.
+const (
+	Park   PostTeamUpdateSettingsJSONBodyNoCandidatePolicy = "park"
+	Remove PostTeamUpdateSettingsJSONBodyNoCandidatePolicy = "remove"
+)
+
+// Defines values for PostTeamUpdateSettingsJSONBodyReviewerSelection.
+const (
+	PostTeamUpdateSettingsJSONBodyReviewerSelectionRandom       PostTeamUpdateSettingsJSONBodyReviewerSelection = "random"
+	PostTeamUpdateSettingsJSONBodyReviewerSelectionWorkingHours PostTeamUpdateSettingsJSONBodyReviewerSelection = "working_hours"
+)
+
+// Defines values for GetUsersListParamsOrder.
+const (
+	Asc  GetUsersListParamsOrder = "asc"
+	Desc GetUsersListParamsOrder = "desc"
+)
+
+// Defines values for GetUsersListParamsSortBy.
+const (
+	UserId   GetUsersListParamsSortBy = "user_id"
+	Username GetUsersListParamsSortBy = "username"
+)
+
+// Defines values for PostUsersTransferJSONBodyReviewPolicy.
+const (
+	Fail     PostUsersTransferJSONBodyReviewPolicy = "fail"
+	Keep     PostUsersTransferJSONBodyReviewPolicy = "keep"
+	Reassign PostUsersTransferJSONBodyReviewPolicy = "reassign"
+)
+
+// Absence defines model for Absence.
+type Absence struct {
+	AbsenceId int64     `json:"absence_id"`
+	EndsAt    time.Time `json:"ends_at"`
+	Reason    string    `json:"reason"`
+
+	// ReassignOnStart Переназначить открытые ревью пользователя в момент начала отсутствия
+	ReassignOnStart bool `json:"reassign_on_start"`
+
+	// ReassignedAt Когда ревью были переназначены
+	ReassignedAt *time.Time `json:"reassigned_at"`
+	StartsAt     time.Time  `json:"starts_at"`
+	UserId       string     `json:"user_id"`
+}
+
+// DurationPercentiles defines model for DurationPercentiles.
+type DurationPercentiles struct {
+	// Count Сколько PR учтено
+	Count      int      `json:"count"`
+	P50Seconds *float64 `json:"p50_seconds"`
+	P90Seconds *float64 `json:"p90_seconds"`
+	P99Seconds *float64 `json:"p99_seconds"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Conflicts Для MEMBER_CONFLICT - участники, из-за которых запрос отклонён
+		Conflicts *[]MemberConflict `json:"conflicts,omitempty"`
+		Message   string            `json:"message"`
 	} `json:"error"`
 }
 
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ExternalTeam defines model for ExternalTeam.
+type ExternalTeam struct {
+	// ExternalTeam Команда на хостинге кода (для GitHub - slug, для GitLab - полный путь группы)
+	ExternalTeam string   `json:"external_team"`
+	Provider     Provider `json:"provider"`
+	TeamName     string   `json:"team_name"`
+}
+
+// ExternalUser defines model for ExternalUser.
+type ExternalUser struct {
+	// Login Логин на хостинге кода (без учёта регистра)
+	Login    string   `json:"login"`
+	Provider Provider `json:"provider"`
+	UserId   string   `json:"user_id"`
+}
+
+// LoadDistribution defines model for LoadDistribution.
+type LoadDistribution struct {
+	// Gini Коэффициент Джини, 0 - нагрузка распределена поровну
+	Gini float64 `json:"gini"`
+
+	// MaxMinRatio Отношение максимальной нагрузки к минимальной, null если минимум равен нулю
+	MaxMinRatio *float64 `json:"max_min_ratio"`
+	Mean        float64  `json:"mean"`
+
+	// Members Сколько участников учтено
+	Members int     `json:"members"`
+	StdDev  float64 `json:"std_dev"`
+}
+
+// MemberConflict defines model for MemberConflict.
+type MemberConflict struct {
+	// CurrentTeamName Текущая команда пользователя (для OTHER_TEAM)
+	CurrentTeamName *string `json:"current_team_name,omitempty"`
+
+	// Reason OTHER_TEAM - пользователь уже состоит в другой команде, DUPLICATE - user_id повторяется в запросе
+	Reason MemberConflictReason `json:"reason"`
+	UserId string               `json:"user_id"`
+}
+
+// MemberConflictReason OTHER_TEAM - пользователь уже состоит в другой команде, DUPLICATE - user_id повторяется в запросе
+type MemberConflictReason string
+
+// MemberLoad defines model for MemberLoad.
+type MemberLoad struct {
+	Assignments int `json:"assignments"`
+
+	// AvailableShare Доля периода, когда участник был активен и не в отсутствии. В adjusted
+	// нагрузка делится на эту долю; участники с долей меньше 0.1 не учитываются
+	AvailableShare float64 `json:"available_share"`
+	IsActive       bool    `json:"is_active"`
+	UserId         string  `json:"user_id"`
+}
+
+// Provider defines model for Provider.
+type Provider string
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (не больше reviewer_count команды автора)
+	AssignedReviewers []string `json:"assigned_reviewers"`
+	AuthorId          string   `json:"author_id"`
+
+	// ClosedAt Когда PR закрыли без merge
+	ClosedAt  *time.Time `json:"closedAt"`
+	CreatedAt *time.Time `json:"createdAt"`
+
+	// FirstDecisionAt Когда кто-то из ревьюверов впервые принял решение
+	FirstDecisionAt *time.Time        `json:"firstDecisionAt"`
+	MergedAt        *time.Time        `json:"mergedAt"`
+	PullRequestId   string            `json:"pull_request_id"`
+	PullRequestName string            `json:"pull_request_name"`
+	Status          PullRequestStatus `json:"status"`
+
+	// TeamName Команда, которой принадлежит PR и из которой выбираются ревьюверы
+	TeamName *string `json:"team_name,omitempty"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -78,40 +372,445 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReviewBackfill defines model for ReviewBackfill.
+type ReviewBackfill struct {
+	// AddedReviewers user_id добавленных ревьюверов
+	AddedReviewers []string `json:"added_reviewers"`
+	PullRequestId  string   `json:"pull_request_id"`
+	TeamName       string   `json:"team_name"`
+}
+
+// ReviewReassignment defines model for ReviewReassignment.
+type ReviewReassignment struct {
+	// NewReviewerId user_id нового ревьювера, null если кандидат не найден
+	NewReviewerId *string `json:"new_reviewer_id"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+
+	// Outcome REPLACED - слот передан new_reviewer_id; если кандидата нет, слот освобождён (PARKED)
+	// или убран (REMOVED) по настройке команды no_candidate_policy
+	Outcome       ReviewReassignmentOutcome `json:"outcome"`
+	PullRequestId string                    `json:"pull_request_id"`
+}
+
+// ReviewReassignmentOutcome REPLACED - слот передан new_reviewer_id; если кандидата нет, слот освобождён (PARKED)
+// или убран (REMOVED) по настройке команды no_candidate_policy
+type ReviewReassignmentOutcome string
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	// ArchivedAt Время архивации, null для действующей команды
+	ArchivedAt *time.Time   `json:"archived_at"`
+	Members    []TeamMember `json:"members"`
+
+	// Overrides Настройки, заданные на самой команде; null - значение наследуется от родительской команды
+	Overrides *TeamSettingsOverrides `json:"overrides,omitempty"`
+
+	// ParentTeamName Родительская команда, null для команды верхнего уровня
+	ParentTeamName *string       `json:"parent_team_name"`
+	Settings       *TeamSettings `json:"settings,omitempty"`
+	TeamName       string        `json:"team_name"`
+}
+
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	Adjusted LoadDistribution `json:"adjusted"`
+
+	// Imbalanced adjusted.gini превышает порог
+	Imbalanced bool             `json:"imbalanced"`
+	Members    []MemberLoad     `json:"members"`
+	Raw        LoadDistribution `json:"raw"`
+	TeamName   string           `json:"team_name"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// Role Роль в команде. LEAD меняет настройки команды, деактивирует участников
+	// и переназначает чужие ревью; просроченные ревью эскалируются на LEAD,
+	// а при его отсутствии - на MAINTAINER
+	Role     *TeamRole `json:"role,omitempty"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
+}
+
+// TeamMembership defines model for TeamMembership.
+type TeamMembership struct {
+	Primary bool `json:"primary"`
+
+	// Role Роль в команде. LEAD меняет настройки команды, деактивирует участников
+	// и переназначает чужие ревью; просроченные ревью эскалируются на LEAD,
+	// а при его отсутствии - на MAINTAINER
+	Role     TeamRole `json:"role"`
+	TeamName string   `json:"team_name"`
+}
+
+// TeamNode defines model for TeamNode.
+type TeamNode struct {
+	ArchivedAt  *time.Time `json:"archived_at"`
+	Children    []TeamNode `json:"children"`
+	MemberCount int        `json:"member_count"`
+
+	// Overrides Настройки, заданные на самой команде; null - значение наследуется от родительской команды
+	Overrides      *TeamSettingsOverrides `json:"overrides,omitempty"`
+	ParentTeamName *string                `json:"parent_team_name"`
+	Settings       TeamSettings           `json:"settings"`
+	TeamName       string                 `json:"team_name"`
+}
+
+// TeamReviewStats defines model for TeamReviewStats.
+type TeamReviewStats struct {
+	Assignments    int    `json:"assignments"`
+	MergedReviewed int    `json:"merged_reviewed"`
+	OpenLoad       int    `json:"open_load"`
+	ReassignedAway int    `json:"reassigned_away"`
+	ReassignedIn   int    `json:"reassigned_in"`
+	TeamName       string `json:"team_name"`
+}
+
+// TeamRole Роль в команде. LEAD меняет настройки команды, деактивирует участников
+// и переназначает чужие ревью; просроченные ревью эскалируются на LEAD,
+// а при его отсутствии - на MAINTAINER
+type TeamRole string
+
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// EscalateToParent Брать ревьюверов из родительской команды и её подкоманд, если в своей команде кандидатов нет
+	EscalateToParent bool `json:"escalate_to_parent"`
+
+	// MergePolicy Что нужно для /pullRequest/merge: any - ничего, approved - хотя бы одно одобрение
+	// и ни одного запроса изменений, all_approved - одобрение от каждого ревьювера
+	MergePolicy TeamSettingsMergePolicy `json:"merge_policy"`
+
+	// NoCandidatePolicy Что делать при массовом переназначении (деактивация, отсутствие, выход из команды)
+	// со слотом, который некому передать: park - освободить, его заполнит добор ревьюверов,
+	// remove - убрать, у PR станет меньше ревьюверов
+	NoCandidatePolicy TeamSettingsNoCandidatePolicy `json:"no_candidate_policy"`
+
+	// ReassignOnDeactivate Переназначать открытые ревью при деактивации участника (по умолчанию для /users/setIsActive)
+	ReassignOnDeactivate bool `json:"reassign_on_deactivate"`
+
+	// ReviewSlaHours SLA на ревью в рабочих часах ревьювера, null - без SLA
+	ReviewSlaHours *int `json:"review_sla_hours"`
+
+	// ReviewerCount Сколько ревьюверов назначать на новый PR
+	ReviewerCount int `json:"reviewer_count"`
+
+	// ReviewerSelection Способ выбора ревьюверов: random - случайно, working_hours - сначала те,
+	// у кого сейчас рабочее время, затем те, у кого оно начнётся раньше
+	ReviewerSelection TeamSettingsReviewerSelection `json:"reviewer_selection"`
+}
+
+// TeamSettingsMergePolicy Что нужно для /pullRequest/merge: any - ничего, approved - хотя бы одно одобрение
+// и ни одного запроса изменений, all_approved - одобрение от каждого ревьювера
+type TeamSettingsMergePolicy string
+
+// TeamSettingsNoCandidatePolicy Что делать при массовом переназначении (деактивация, отсутствие, выход из команды)
+// со слотом, который некому передать: park - освободить, его заполнит добор ревьюверов,
+// remove - убрать, у PR станет меньше ревьюверов
+type TeamSettingsNoCandidatePolicy string
+
+// TeamSettingsReviewerSelection Способ выбора ревьюверов: random - случайно, working_hours - сначала те,
+// у кого сейчас рабочее время, затем те, у кого оно начнётся раньше
+type TeamSettingsReviewerSelection string
+
+// TeamSettingsOverrides Настройки, заданные на самой команде; null - значение наследуется от родительской команды
+type TeamSettingsOverrides struct {
+	EscalateToParent     *bool                                   `json:"escalate_to_parent"`
+	MergePolicy          *TeamSettingsOverridesMergePolicy       `json:"merge_policy"`
+	NoCandidatePolicy    *TeamSettingsOverridesNoCandidatePolicy `json:"no_candidate_policy"`
+	ReassignOnDeactivate *bool                                   `json:"reassign_on_deactivate"`
+
+	// ReviewSlaHours 0 - SLA явно отключён
+	ReviewSlaHours    *int                                    `json:"review_sla_hours"`
+	ReviewerCount     *int                                    `json:"reviewer_count"`
+	ReviewerSelection *TeamSettingsOverridesReviewerSelection `json:"reviewer_selection"`
+}
+
+// TeamSettingsOverridesMergePolicy defines model for TeamSettingsOverrides.MergePolicy.
+type TeamSettingsOverridesMergePolicy string
+
+// TeamSettingsOverridesNoCandidatePolicy defines model for TeamSettingsOverrides.NoCandidatePolicy.
+type TeamSettingsOverridesNoCandidatePolicy string
+
+// TeamSettingsOverridesReviewerSelection defines model for TeamSettingsOverrides.ReviewerSelection.
+type TeamSettingsOverridesReviewerSelection string
+
+// TeamSummary defines model for TeamSummary.
+type TeamSummary struct {
+	ActiveCount int        `json:"active_count"`
+	ArchivedAt  *time.Time `json:"archived_at"`
+	MemberCount int        `json:"member_count"`
+
+	// OpenPrCount Количество открытых PR, принадлежащих команде
+	OpenPrCount int    `json:"open_pr_count"`
+	TeamName    string `json:"team_name"`
 }
 
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
+	IsActive bool `json:"is_active"`
+
+	// Role Роль в команде. LEAD меняет настройки команды, деактивирует участников
+	// и переназначает чужие ревью; просроченные ревью эскалируются на LEAD,
+	// а при его отсутствии - на MAINTAINER
+	Role     *TeamRole `json:"role,omitempty"`
+	TeamName string    `json:"team_name"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
+}
+
+// UserProfile defines model for UserProfile.
+type UserProfile struct {
+	IsActive bool `json:"is_active"`
+
+	// OpenAuthoredCount Количество открытых PR, где пользователь автор
+	OpenAuthoredCount int `json:"open_authored_count"`
+
+	// OpenReviewCount Количество открытых PR, где пользователь назначен ревьювером
+	OpenReviewCount int `json:"open_review_count"`
+
+	// TeamName Основная команда
 	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+
+	// Teams Все команды пользователя, основная первой
+	Teams        []TeamMembership `json:"teams"`
+	UserId       string           `json:"user_id"`
+	Username     string           `json:"username"`
+	WorkingHours WorkingHours     `json:"working_hours"`
 }
 
+// UserReviewStats defines model for UserReviewStats.
+type UserReviewStats struct {
+	// Assignments Сколько раз пользователь назначался ревьювером за период
+	Assignments int `json:"assignments"`
+
+	// MergedReviewed Сколько PR, где пользователь ревьювер, смержено за период
+	MergedReviewed int `json:"merged_reviewed"`
+
+	// OpenLoad Текущее число ревью на открытых PR (без учёта периода)
+	OpenLoad int `json:"open_load"`
+
+	// ReassignedAway Сколько ревью у пользователя забрали переназначением
+	ReassignedAway int `json:"reassigned_away"`
+
+	// ReassignedIn Сколько ревью пользователь получил переназначением
+	ReassignedIn int    `json:"reassigned_in"`
+	UserId       string `json:"user_id"`
+	Username     string `json:"username"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int              `json:"attempts"`
+	CreatedAt   time.Time        `json:"created_at"`
+	DeliveredAt *time.Time       `json:"delivered_at"`
+	DeliveryId  int64            `json:"delivery_id"`
+	Event       WebhookEventType `json:"event"`
+
+	// EventId id события; одинаков у всех доставок события, в том числе повторных
+	EventId       string     `json:"event_id"`
+	LastAttemptAt *time.Time `json:"last_attempt_at"`
+	LastError     *string    `json:"last_error"`
+	NextAttemptAt *time.Time `json:"next_attempt_at"`
+
+	// Payload Тело доставки. Состав data зависит от type:
+	// - pr.created, pr.merged - PullRequest;
+	// - reviewer.assigned - pull_request_id, team_name, reviewer_id и reason
+	//   (created, backfill или escalation);
+	// - reviewer.reassigned - pull_request_id, old_reviewer_id, new_reviewer_id (null, если
+	//   слот снят без замены), outcome (REPLACED, REMOVED или PARKED, как в ReviewReassignment)
+	//   и reason (manual, deactivation, membership или absence). Событие отправляется только
+	//   для слотов, которые действительно изменились;
+	// - user.deactivated - user_id.
+	Payload WebhookEvent `json:"payload"`
+
+	// RedeliveryOf Доставка, которую повторяет эта
+	RedeliveryOf *int64 `json:"redelivery_of"`
+
+	// ResponseStatus HTTP-статус последнего ответа, null если ответа не было
+	ResponseStatus *int `json:"response_status"`
+
+	// Status FAILED - попытки исчерпаны
+	Status         WebhookDeliveryStatus `json:"status"`
+	SubscriptionId int64                 `json:"subscription_id"`
+}
+
+// WebhookDeliveryStatus FAILED - попытки исчерпаны
+type WebhookDeliveryStatus string
+
+// WebhookEvent Тело доставки. Состав data зависит от type:
+//   - pr.created, pr.merged - PullRequest;
+//   - reviewer.assigned - pull_request_id, team_name, reviewer_id и reason
+//     (created, backfill или escalation);
+//   - reviewer.reassigned - pull_request_id, old_reviewer_id, new_reviewer_id (null, если
+//     слот снят без замены), outcome (REPLACED, REMOVED или PARKED, как в ReviewReassignment)
+//     и reason (manual, deactivation, membership или absence). Событие отправляется только
+//     для слотов, которые действительно изменились;
+//   - user.deactivated - user_id.
+type WebhookEvent struct {
+	Data       map[string]interface{} `json:"data"`
+	Id         string                 `json:"id"`
+	OccurredAt time.Time              `json:"occurred_at"`
+	Type       WebhookEventType       `json:"type"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt      time.Time          `json:"created_at"`
+	Events         []WebhookEventType `json:"events"`
+	SubscriptionId int64              `json:"subscription_id"`
+	Url            string             `json:"url"`
+}
+
+// WeeklyThroughput defines model for WeeklyThroughput.
+type WeeklyThroughput struct {
+	Merged int `json:"merged"`
+
+	// WeekStart Понедельник недели (UTC)
+	WeekStart openapi_types.Date `json:"week_start"`
+}
+
+// WorkingHours defines model for WorkingHours.
+type WorkingHours struct {
+	// End Конец рабочего дня (HH:MM)
+	End string `json:"end"`
+
+	// Start Начало рабочего дня (HH:MM), пн-пт
+	Start string `json:"start"`
+
+	// Timezone Часовой пояс IANA, например Europe/Moscow
+	Timezone string `json:"timezone"`
+}
+
+// AbsenceIdQuery defines model for AbsenceIdQuery.
+type AbsenceIdQuery int64
+
+// ActorTokenHeader defines model for ActorTokenHeader.
+type ActorTokenHeader string
+
+// AdminTokenHeader defines model for AdminTokenHeader.
+type AdminTokenHeader string
+
+// CursorQuery defines model for CursorQuery.
+type CursorQuery string
+
+// LimitQuery defines model for LimitQuery.
+type LimitQuery int
+
+// OrderQuery defines model for OrderQuery.
+type OrderQuery string
+
+// ProviderQuery defines model for ProviderQuery.
+type ProviderQuery Provider
+
+// StatsFromQuery defines model for StatsFromQuery.
+type StatsFromQuery time.Time
+
+// StatsToQuery defines model for StatsToQuery.
+type StatsToQuery time.Time
+
+// SubscriptionIdQuery defines model for SubscriptionIdQuery.
+type SubscriptionIdQuery int64
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery string
 
+// GetExportDatasetParams defines parameters for GetExportDataset.
+type GetExportDatasetParams struct {
+	Format *GetExportDatasetParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// From Начало периода (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно)
+	To           *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Pseudonymize *bool      `form:"pseudonymize,omitempty" json:"pseudonymize,omitempty"`
+
+	// Limit Размер страницы
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор из заголовка X-Next-Cursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetExportDatasetParamsFormat defines parameters for GetExportDataset.
+type GetExportDatasetParamsFormat string
+
+// GetExportDatasetParamsDataset defines parameters for GetExportDataset.
+type GetExportDatasetParamsDataset string
+
+// GetIntegrationsMappingsParams defines parameters for GetIntegrationsMappings.
+type GetIntegrationsMappingsParams struct {
+	// Provider Хостинг кода
+	Provider ProviderQuery `form:"provider" json:"provider"`
+}
+
+// PostIntegrationsSetTeamMappingJSONBody defines parameters for PostIntegrationsSetTeamMapping.
+type PostIntegrationsSetTeamMappingJSONBody struct {
+	ExternalTeam string   `json:"external_team"`
+	Provider     Provider `json:"provider"`
+	TeamName     *string  `json:"team_name"`
+}
+
+// PostIntegrationsSetTeamMappingParams defines parameters for PostIntegrationsSetTeamMapping.
+type PostIntegrationsSetTeamMappingParams struct {
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostIntegrationsSetUserMappingJSONBody defines parameters for PostIntegrationsSetUserMapping.
+type PostIntegrationsSetUserMappingJSONBody struct {
+	Login    string   `json:"login"`
+	Provider Provider `json:"provider"`
+	UserId   *string  `json:"user_id"`
+}
+
+// PostIntegrationsSetUserMappingParams defines parameters for PostIntegrationsSetUserMapping.
+type PostIntegrationsSetUserMappingParams struct {
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
 	AuthorId        string `json:"author_id"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
+
+	// TeamName Команда автора, которой принадлежит PR; по умолчанию основная команда автора
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// PostPullRequestDecideJSONBody defines parameters for PostPullRequestDecide.
+type PostPullRequestDecideJSONBody struct {
+	Decision      PostPullRequestDecideJSONBodyDecision `json:"decision"`
+	PullRequestId string                                `json:"pull_request_id"`
+}
+
+// PostPullRequestDecideParams defines parameters for PostPullRequestDecide.
+type PostPullRequestDecideParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
 }
 
+// PostPullRequestDecideJSONBodyDecision defines parameters for PostPullRequestDecide.
+type PostPullRequestDecideJSONBodyDecision string
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
@@ -119,78 +818,875 @@ type PostPullRequestMergeJSONBody struct {
 
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+	OldUserId string `json:"old_user_id"`
+
+	// OnNoCandidate Что делать, если заменить ревьювера некем:
+	// fail - вернуть NO_CANDIDATE и оставить ревьювера,
+	// remove - снять ревьювера и уменьшить число ревьюверов PR,
+	// park - снять ревьювера и оставить слот пустым, его заполнит добор ревьюверов
+	OnNoCandidate *PostPullRequestReassignJSONBodyOnNoCandidate `json:"on_no_candidate,omitempty"`
+	PullRequestId string                                        `json:"pull_request_id"`
 }
 
-// GetTeamGetParams defines parameters for GetTeamGet.
-type GetTeamGetParams struct {
-	// TeamName Уникальное имя команды
-	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
 }
 
-// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
-type GetUsersGetReviewParams struct {
-	// UserId Идентификатор пользователя
-	UserId UserIdQuery `form:"user_id" json:"user_id"`
+// PostPullRequestReassignJSONBodyOnNoCandidate defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBodyOnNoCandidate string
+
+// GetPullRequestSlaParams defines parameters for GetPullRequestSla.
+type GetPullRequestSlaParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
 }
 
-// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
-type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало периода (включительно). Статистика считается по дневным агрегатам (UTC),
+	// поэтому начало округляется вниз до начала суток.
+	From *StatsFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода (не включительно). Округляется вверх до начала следующих суток (UTC).
+	// Агрегаты обновляются фоновой задачей и могут отставать на интервал
+	// STATS_ROLLUP_INTERVAL.
+	To            *StatsToQuery `form:"to,omitempty" json:"to,omitempty"`
+	GiniThreshold *float64      `form:"gini_threshold,omitempty" json:"gini_threshold,omitempty"`
 }
 
-// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+// GetStatsReviewersParams defines parameters for GetStatsReviewers.
+type GetStatsReviewersParams struct {
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
 
-// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
-type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+	// From Начало периода (включительно). Статистика считается по дневным агрегатам (UTC),
+	// поэтому начало округляется вниз до начала суток.
+	From *StatsFromQuery `form:"from,omitempty" json:"from,omitempty"`
 
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+	// To Конец периода (не включительно). Округляется вверх до начала следующих суток (UTC).
+	// Агрегаты обновляются фоновой задачей и могут отставать на интервал
+	// STATS_ROLLUP_INTERVAL.
+	To *StatsToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
 
-// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody Team
+// GetStatsTeamParams defines parameters for GetStatsTeam.
+type GetStatsTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 
-// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
-type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+	// From Начало периода (включительно). Статистика считается по дневным агрегатам (UTC),
+	// поэтому начало округляется вниз до начала суток.
+	From *StatsFromQuery `form:"from,omitempty" json:"from,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
+	// To Конец периода (не включительно). Округляется вверх до начала следующих суток (UTC).
+	// Агрегаты обновляются фоновой задачей и могут отставать на интервал
+	// STATS_ROLLUP_INTERVAL.
+	To *StatsToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// DeleteSubscriptionsParams defines parameters for DeleteSubscriptions.
+type DeleteSubscriptionsParams struct {
+	// SubscriptionId Идентификатор подписки
+	SubscriptionId SubscriptionIdQuery `form:"subscription_id" json:"subscription_id"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostSubscriptionsAddJSONBody defines parameters for PostSubscriptionsAdd.
+type PostSubscriptionsAddJSONBody struct {
+	Events []WebhookEventType `json:"events"`
+	Secret *string            `json:"secret,omitempty"`
+	Url    string             `json:"url"`
+}
+
+// PostSubscriptionsAddParams defines parameters for PostSubscriptionsAdd.
+type PostSubscriptionsAddParams struct {
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// GetSubscriptionsDeliveriesParams defines parameters for GetSubscriptionsDeliveries.
+type GetSubscriptionsDeliveriesParams struct {
+	SubscriptionId *int64                                  `form:"subscription_id,omitempty" json:"subscription_id,omitempty"`
+	Status         *GetSubscriptionsDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор next_cursor из предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// GetSubscriptionsDeliveriesParamsStatus defines parameters for GetSubscriptionsDeliveries.
+type GetSubscriptionsDeliveriesParamsStatus string
+
+// GetSubscriptionsListParams defines parameters for GetSubscriptionsList.
+type GetSubscriptionsListParams struct {
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostSubscriptionsRedeliverJSONBody defines parameters for PostSubscriptionsRedeliver.
+type PostSubscriptionsRedeliverJSONBody struct {
+	DeliveryId int64 `json:"delivery_id"`
+}
+
+// PostSubscriptionsRedeliverParams defines parameters for PostSubscriptionsRedeliver.
+type PostSubscriptionsRedeliverParams struct {
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// DeleteTeamParams defines parameters for DeleteTeam.
+type DeleteTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostTeamAddJSONBody defines parameters for PostTeamAdd.
+type PostTeamAddJSONBody struct {
+	// ConflictPolicy Что делать с участниками, которые уже состоят в другой команде:
+	// fail - отклонить запрос со списком конфликтов, move - перенести в новую команду,
+	// skip - не трогать их и создать команду без них.
+	// Повторяющиеся user_id отклоняются при любой политике
+	ConflictPolicy *PostTeamAddJSONBodyConflictPolicy `json:"conflict_policy,omitempty"`
+	Members        []TeamMember                       `json:"members"`
+
+	// ParentTeamName Родительская команда, от которой наследуются настройки
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+	TeamName       string  `json:"team_name"`
+}
+
+// PostTeamAddParams defines parameters for PostTeamAdd.
+type PostTeamAddParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostTeamAddJSONBodyConflictPolicy defines parameters for PostTeamAdd.
+type PostTeamAddJSONBodyConflictPolicy string
+
+// PostTeamAddMemberJSONBody defines parameters for PostTeamAddMember.
+type PostTeamAddMemberJSONBody struct {
+	// Role Роль в команде. LEAD меняет настройки команды, деактивирует участников
+	// и переназначает чужие ревью; просроченные ревью эскалируются на LEAD,
+	// а при его отсутствии - на MAINTAINER
+	Role     *TeamRole `json:"role,omitempty"`
+	TeamName string    `json:"team_name"`
+	UserId   string    `json:"user_id"`
+}
+
+// PostTeamAddMemberParams defines parameters for PostTeamAddMember.
+type PostTeamAddMemberParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostTeamArchiveJSONBody defines parameters for PostTeamArchive.
+type PostTeamArchiveJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// PostTeamArchiveParams defines parameters for PostTeamArchive.
+type PostTeamArchiveParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostTeamBackfillReviewersJSONBody defines parameters for PostTeamBackfillReviewers.
+type PostTeamBackfillReviewersJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// PostTeamBackfillReviewersParams defines parameters for PostTeamBackfillReviewers.
+type PostTeamBackfillReviewersParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostTeamDeactivateMembersJSONBody defines parameters for PostTeamDeactivateMembers.
+type PostTeamDeactivateMembersJSONBody struct {
+	// AllExcept Деактивировать всех участников команды, кроме user_ids
+	AllExcept *bool  `json:"all_except,omitempty"`
+	TeamName  string `json:"team_name"`
+
+	// UserIds Кого деактивировать (или кого оставить, если all_except=true)
+	UserIds []string `json:"user_ids"`
+}
+
+// PostTeamDeactivateMembersParams defines parameters for PostTeamDeactivateMembers.
+type PostTeamDeactivateMembersParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamListParams defines parameters for GetTeamList.
+type GetTeamListParams struct {
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор next_cursor из предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Order Направление сортировки
+	Order           *GetTeamListParamsOrder  `form:"order,omitempty" json:"order,omitempty"`
+	SortBy          *GetTeamListParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+	IncludeArchived *bool                    `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+}
+
+// GetTeamListParamsOrder defines parameters for GetTeamList.
+type GetTeamListParamsOrder string
+
+// GetTeamListParamsSortBy defines parameters for GetTeamList.
+type GetTeamListParamsSortBy string
+
+// PostTeamRemoveMemberJSONBody defines parameters for PostTeamRemoveMember.
+type PostTeamRemoveMemberJSONBody struct {
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// PostTeamRemoveMemberParams defines parameters for PostTeamRemoveMember.
+type PostTeamRemoveMemberParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostTeamRenameJSONBody defines parameters for PostTeamRename.
+type PostTeamRenameJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// PostTeamRenameParams defines parameters for PostTeamRename.
+type PostTeamRenameParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostTeamSetMemberRoleJSONBody defines parameters for PostTeamSetMemberRole.
+type PostTeamSetMemberRoleJSONBody struct {
+	// Role Роль в команде. LEAD меняет настройки команды, деактивирует участников
+	// и переназначает чужие ревью; просроченные ревью эскалируются на LEAD,
+	// а при его отсутствии - на MAINTAINER
+	Role     TeamRole `json:"role"`
+	TeamName string   `json:"team_name"`
+	UserId   string   `json:"user_id"`
+}
+
+// PostTeamSetMemberRoleParams defines parameters for PostTeamSetMemberRole.
+type PostTeamSetMemberRoleParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostTeamSetParentJSONBody defines parameters for PostTeamSetParent.
+type PostTeamSetParentJSONBody struct {
+	// ParentTeamName Новая родительская команда, null или отсутствие - команда верхнего уровня
+	ParentTeamName *string `json:"parent_team_name"`
+	TeamName       string  `json:"team_name"`
+}
+
+// PostTeamSetParentParams defines parameters for PostTeamSetParent.
+type PostTeamSetParentParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// GetTeamTreeParams defines parameters for GetTeamTree.
+type GetTeamTreeParams struct {
+	// TeamName Корень поддерева; без параметра возвращаются все команды верхнего уровня
+	TeamName        *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	IncludeArchived *bool   `form:"include_archived,omitempty" json:"include_archived,omitempty"`
+}
+
+// PostTeamUpdateSettingsJSONBody defines parameters for PostTeamUpdateSettings.
+type PostTeamUpdateSettingsJSONBody struct {
+	EscalateToParent *bool `json:"escalate_to_parent,omitempty"`
+
+	// Inherit Настройки, которые нужно снова наследовать от родительской команды
+	Inherit              *[]PostTeamUpdateSettingsJSONBodyInherit         `json:"inherit,omitempty"`
+	MergePolicy          *PostTeamUpdateSettingsJSONBodyMergePolicy       `json:"merge_policy,omitempty"`
+	NoCandidatePolicy    *PostTeamUpdateSettingsJSONBodyNoCandidatePolicy `json:"no_candidate_policy,omitempty"`
+	ReassignOnDeactivate *bool                                            `json:"reassign_on_deactivate,omitempty"`
+
+	// ReviewSlaHours 0 отключает SLA
+	ReviewSlaHours    *int                                             `json:"review_sla_hours,omitempty"`
+	ReviewerCount     *int                                             `json:"reviewer_count,omitempty"`
+	ReviewerSelection *PostTeamUpdateSettingsJSONBodyReviewerSelection `json:"reviewer_selection,omitempty"`
+	TeamName          string                                           `json:"team_name"`
+}
+
+// PostTeamUpdateSettingsParams defines parameters for PostTeamUpdateSettings.
+type PostTeamUpdateSettingsParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostTeamUpdateSettingsJSONBodyInherit defines parameters for PostTeamUpdateSettings.
+type PostTeamUpdateSettingsJSONBodyInherit string
+
+// PostTeamUpdateSettingsJSONBodyMergePolicy defines parameters for PostTeamUpdateSettings.
+type PostTeamUpdateSettingsJSONBodyMergePolicy string
+
+// PostTeamUpdateSettingsJSONBodyNoCandidatePolicy defines parameters for PostTeamUpdateSettings.
+type PostTeamUpdateSettingsJSONBodyNoCandidatePolicy string
+
+// PostTeamUpdateSettingsJSONBodyReviewerSelection defines parameters for PostTeamUpdateSettings.
+type PostTeamUpdateSettingsJSONBodyReviewerSelection string
+
+// DeleteUsersAbsenceParams defines parameters for DeleteUsersAbsence.
+type DeleteUsersAbsenceParams struct {
+	// AbsenceId Идентификатор отсутствия
+	AbsenceId AbsenceIdQuery `form:"absence_id" json:"absence_id"`
+
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostUsersAbsenceAddJSONBody defines parameters for PostUsersAbsenceAdd.
+type PostUsersAbsenceAddJSONBody struct {
+	EndsAt          time.Time `json:"ends_at"`
+	Reason          *string   `json:"reason,omitempty"`
+	ReassignOnStart *bool     `json:"reassign_on_start,omitempty"`
+	StartsAt        time.Time `json:"starts_at"`
+	UserId          string    `json:"user_id"`
+}
+
+// PostUsersAbsenceAddParams defines parameters for PostUsersAbsenceAdd.
+type PostUsersAbsenceAddParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// GetUsersAbsenceListParams defines parameters for GetUsersAbsenceList.
+type GetUsersAbsenceListParams struct {
+	// UserId Идентификатор пользователя
+	UserId      UserIdQuery `form:"user_id" json:"user_id"`
+	IncludePast *bool       `form:"include_past,omitempty" json:"include_past,omitempty"`
+}
+
+// PostUsersAbsenceUpdateJSONBody defines parameters for PostUsersAbsenceUpdate.
+type PostUsersAbsenceUpdateJSONBody struct {
+	AbsenceId       int64      `json:"absence_id"`
+	EndsAt          *time.Time `json:"ends_at,omitempty"`
+	Reason          *string    `json:"reason,omitempty"`
+	ReassignOnStart *bool      `json:"reassign_on_start,omitempty"`
+	StartsAt        *time.Time `json:"starts_at,omitempty"`
+}
+
+// PostUsersAbsenceUpdateParams defines parameters for PostUsersAbsenceUpdate.
+type PostUsersAbsenceUpdateParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// GetUsersGetParams defines parameters for GetUsersGet.
+type GetUsersGetParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersIssueTokenJSONBody defines parameters for PostUsersIssueToken.
+type PostUsersIssueTokenJSONBody struct {
+	TtlSeconds *int   `json:"ttl_seconds,omitempty"`
+	UserId     string `json:"user_id"`
+}
+
+// PostUsersIssueTokenParams defines parameters for PostUsersIssueToken.
+type PostUsersIssueTokenParams struct {
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// GetUsersListParams defines parameters for GetUsersList.
+type GetUsersListParams struct {
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор next_cursor из предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Order Направление сортировки
+	Order  *GetUsersListParamsOrder  `form:"order,omitempty" json:"order,omitempty"`
+	SortBy *GetUsersListParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// TeamName Только участники команды (основной или дополнительной)
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	IsActive *bool   `form:"is_active,omitempty" json:"is_active,omitempty"`
+
+	// Search Подстрока username (без учёта регистра)
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+}
+
+// GetUsersListParamsOrder defines parameters for GetUsersList.
+type GetUsersListParamsOrder string
+
+// GetUsersListParamsSortBy defines parameters for GetUsersList.
+type GetUsersListParamsSortBy string
+
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool `json:"is_active"`
+
+	// ReassignReviews При деактивации переназначить открытые ревью пользователя.
+	// Если не задано, используется настройка команды reassign_on_deactivate
+	ReassignReviews *bool  `json:"reassign_reviews,omitempty"`
+	UserId          string `json:"user_id"`
+}
+
+// PostUsersSetIsActiveParams defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostUsersTransferJSONBody defines parameters for PostUsersTransfer.
+type PostUsersTransferJSONBody struct {
+	// ReviewPolicy Что делать с открытыми ревью пользователя:
+	// keep - оставить, reassign - переназначить внутри старой команды,
+	// fail - отклонить перевод, если открытые ревью есть
+	ReviewPolicy *PostUsersTransferJSONBodyReviewPolicy `json:"review_policy,omitempty"`
+	TeamName     string                                 `json:"team_name"`
+	UserId       string                                 `json:"user_id"`
+}
+
+// PostUsersTransferParams defines parameters for PostUsersTransfer.
+type PostUsersTransferParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostUsersTransferJSONBodyReviewPolicy defines parameters for PostUsersTransfer.
+type PostUsersTransferJSONBodyReviewPolicy string
+
+// PostUsersUpdateJSONBody defines parameters for PostUsersUpdate.
+type PostUsersUpdateJSONBody struct {
+	// Timezone Часовой пояс IANA
+	Timezone *string `json:"timezone,omitempty"`
+	UserId   string  `json:"user_id"`
+	Username *string `json:"username,omitempty"`
+
+	// WorkEnd Конец рабочего дня (HH:MM)
+	WorkEnd *string `json:"work_end,omitempty"`
+
+	// WorkStart Начало рабочего дня (HH:MM)
+	WorkStart *string `json:"work_start,omitempty"`
+}
+
+// PostUsersUpdateParams defines parameters for PostUsersUpdate.
+type PostUsersUpdateParams struct {
+	// XActorToken Токен пользователя, выполняющего действие, выданный через /users/issueToken.
+	// Токен подписан секретом ACTOR_TOKEN_SECRET и действует до истечения срока;
+	// поддельный, просроченный токен или токен без настроенного секрета отклоняется с 401.
+	XActorToken *ActorTokenHeader `json:"X-Actor-Token,omitempty"`
+
+	// XAdminToken Токен администратора (переменная окружения ADMIN_TOKEN). С ним действие разрешено
+	// без проверки роли; неверный токен отклоняется с 401.
+	XAdminToken *AdminTokenHeader `json:"X-Admin-Token,omitempty"`
+}
+
+// PostWebhooksGithubJSONBody defines parameters for PostWebhooksGithub.
+type PostWebhooksGithubJSONBody map[string]interface{}
+
+// PostWebhooksGithubParams defines parameters for PostWebhooksGithub.
+type PostWebhooksGithubParams struct {
+	XGitHubEvent     string  `json:"X-GitHub-Event"`
+	XHubSignature256 *string `json:"X-Hub-Signature-256,omitempty"`
+}
+
+// PostWebhooksGitlabJSONBody defines parameters for PostWebhooksGitlab.
+type PostWebhooksGitlabJSONBody map[string]interface{}
+
+// PostWebhooksGitlabParams defines parameters for PostWebhooksGitlab.
+type PostWebhooksGitlabParams struct {
+	XGitlabEvent string  `json:"X-Gitlab-Event"`
+	XGitlabToken *string `json:"X-Gitlab-Token,omitempty"`
+}
+
+// PostIntegrationsSetTeamMappingJSONRequestBody defines body for PostIntegrationsSetTeamMapping for application/json ContentType.
+type PostIntegrationsSetTeamMappingJSONRequestBody PostIntegrationsSetTeamMappingJSONBody
+
+// PostIntegrationsSetUserMappingJSONRequestBody defines body for PostIntegrationsSetUserMapping for application/json ContentType.
+type PostIntegrationsSetUserMappingJSONRequestBody PostIntegrationsSetUserMappingJSONBody
+
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+
+// PostPullRequestDecideJSONRequestBody defines body for PostPullRequestDecide for application/json ContentType.
+type PostPullRequestDecideJSONRequestBody PostPullRequestDecideJSONBody
+
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+
+// PostSubscriptionsAddJSONRequestBody defines body for PostSubscriptionsAdd for application/json ContentType.
+type PostSubscriptionsAddJSONRequestBody PostSubscriptionsAddJSONBody
+
+// PostSubscriptionsRedeliverJSONRequestBody defines body for PostSubscriptionsRedeliver for application/json ContentType.
+type PostSubscriptionsRedeliverJSONRequestBody PostSubscriptionsRedeliverJSONBody
+
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody PostTeamAddJSONBody
+
+// PostTeamAddMemberJSONRequestBody defines body for PostTeamAddMember for application/json ContentType.
+type PostTeamAddMemberJSONRequestBody PostTeamAddMemberJSONBody
+
+// PostTeamArchiveJSONRequestBody defines body for PostTeamArchive for application/json ContentType.
+type PostTeamArchiveJSONRequestBody PostTeamArchiveJSONBody
+
+// PostTeamBackfillReviewersJSONRequestBody defines body for PostTeamBackfillReviewers for application/json ContentType.
+type PostTeamBackfillReviewersJSONRequestBody PostTeamBackfillReviewersJSONBody
+
+// PostTeamDeactivateMembersJSONRequestBody defines body for PostTeamDeactivateMembers for application/json ContentType.
+type PostTeamDeactivateMembersJSONRequestBody PostTeamDeactivateMembersJSONBody
+
+// PostTeamRemoveMemberJSONRequestBody defines body for PostTeamRemoveMember for application/json ContentType.
+type PostTeamRemoveMemberJSONRequestBody PostTeamRemoveMemberJSONBody
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody PostTeamRenameJSONBody
+
+// PostTeamSetMemberRoleJSONRequestBody defines body for PostTeamSetMemberRole for application/json ContentType.
+type PostTeamSetMemberRoleJSONRequestBody PostTeamSetMemberRoleJSONBody
+
+// PostTeamSetParentJSONRequestBody defines body for PostTeamSetParent for application/json ContentType.
+type PostTeamSetParentJSONRequestBody PostTeamSetParentJSONBody
+
+// PostTeamUpdateSettingsJSONRequestBody defines body for PostTeamUpdateSettings for application/json ContentType.
+type PostTeamUpdateSettingsJSONRequestBody PostTeamUpdateSettingsJSONBody
+
+// PostUsersAbsenceAddJSONRequestBody defines body for PostUsersAbsenceAdd for application/json ContentType.
+type PostUsersAbsenceAddJSONRequestBody PostUsersAbsenceAddJSONBody
+
+// PostUsersAbsenceUpdateJSONRequestBody defines body for PostUsersAbsenceUpdate for application/json ContentType.
+type PostUsersAbsenceUpdateJSONRequestBody PostUsersAbsenceUpdateJSONBody
+
+// PostUsersIssueTokenJSONRequestBody defines body for PostUsersIssueToken for application/json ContentType.
+type PostUsersIssueTokenJSONRequestBody PostUsersIssueTokenJSONBody
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersTransferJSONRequestBody defines body for PostUsersTransfer for application/json ContentType.
+type PostUsersTransferJSONRequestBody PostUsersTransferJSONBody
+
+// PostUsersUpdateJSONRequestBody defines body for PostUsersUpdate for application/json ContentType.
+type PostUsersUpdateJSONRequestBody PostUsersUpdateJSONBody
+
+// PostWebhooksGithubJSONRequestBody defines body for PostWebhooksGithub for application/json ContentType.
+type PostWebhooksGithubJSONRequestBody PostWebhooksGithubJSONBody
+
+// PostWebhooksGitlabJSONRequestBody defines body for PostWebhooksGitlab for application/json ContentType.
+type PostWebhooksGitlabJSONRequestBody PostWebhooksGitlabJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Выгрузка данных для аналитики в CSV или Parquet
+	// (GET /export/{dataset})
+	GetExportDataset(w http.ResponseWriter, r *http.Request, dataset GetExportDatasetParamsDataset, params GetExportDatasetParams)
+	// Сопоставления пользователей и команд хостинга кода
+	// (GET /integrations/mappings)
+	GetIntegrationsMappings(w http.ResponseWriter, r *http.Request, params GetIntegrationsMappingsParams)
+	// Сопоставить команду на хостинге кода команде
+	// (POST /integrations/setTeamMapping)
+	PostIntegrationsSetTeamMapping(w http.ResponseWriter, r *http.Request, params PostIntegrationsSetTeamMappingParams)
+	// Сопоставить логин на хостинге кода пользователю
+	// (POST /integrations/setUserMapping)
+	PostIntegrationsSetUserMapping(w http.ResponseWriter, r *http.Request, params PostIntegrationsSetUserMappingParams)
+	// Создать PR и автоматически назначить ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Зафиксировать решение ревьювера по PR
+	// (POST /pullRequest/decide)
+	PostPullRequestDecide(w http.ResponseWriter, r *http.Request, params PostPullRequestDecideParams)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
 	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams)
+	// Состояние SLA ревью по каждому ревьюверу (учитываются только рабочие часы ревьювера)
+	// (GET /pullRequest/sla)
+	GetPullRequestSla(w http.ResponseWriter, r *http.Request, params GetPullRequestSlaParams)
+	// Равномерность распределения ревью внутри команд
+	// (GET /stats/fairness)
+	GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams)
+	// Статистика назначений ревьюверов по пользователям и командам
+	// (GET /stats/reviewers)
+	GetStatsReviewers(w http.ResponseWriter, r *http.Request, params GetStatsReviewersParams)
+	// Скорость работы команды с PR
+	// (GET /stats/team)
+	GetStatsTeam(w http.ResponseWriter, r *http.Request, params GetStatsTeamParams)
+	// Удалить подписку вместе с журналом её доставок
+	// (DELETE /subscriptions)
+	DeleteSubscriptions(w http.ResponseWriter, r *http.Request, params DeleteSubscriptionsParams)
+	// Подписать HTTP endpoint на события
+	// (POST /subscriptions/add)
+	PostSubscriptionsAdd(w http.ResponseWriter, r *http.Request, params PostSubscriptionsAddParams)
+	// Журнал доставок, от новых к старым
+	// (GET /subscriptions/deliveries)
+	GetSubscriptionsDeliveries(w http.ResponseWriter, r *http.Request, params GetSubscriptionsDeliveriesParams)
+	// Получить подписки
+	// (GET /subscriptions/list)
+	GetSubscriptionsList(w http.ResponseWriter, r *http.Request, params GetSubscriptionsListParams)
+	// Повторить доставку
+	// (POST /subscriptions/redeliver)
+	PostSubscriptionsRedeliver(w http.ResponseWriter, r *http.Request, params PostSubscriptionsRedeliverParams)
+	// Удалить пустую команду
+	// (DELETE /team)
+	DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
-	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams)
+	// Добавить существующего пользователя в ещё одну команду
+	// (POST /team/addMember)
+	PostTeamAddMember(w http.ResponseWriter, r *http.Request, params PostTeamAddMemberParams)
+	// Архивировать команду (участники деактивируются, история сохраняется)
+	// (POST /team/archive)
+	PostTeamArchive(w http.ResponseWriter, r *http.Request, params PostTeamArchiveParams)
+	// Добрать ревьюверов на открытые PR команды
+	// (POST /team/backfillReviewers)
+	PostTeamBackfillReviewers(w http.ResponseWriter, r *http.Request, params PostTeamBackfillReviewersParams)
+	// Массово деактивировать участников команды и переназначить их открытые ревью
+	// (POST /team/deactivateMembers)
+	PostTeamDeactivateMembers(w http.ResponseWriter, r *http.Request, params PostTeamDeactivateMembersParams)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Список команд со статистикой (постранично)
+	// (GET /team/list)
+	GetTeamList(w http.ResponseWriter, r *http.Request, params GetTeamListParams)
+	// Убрать пользователя из дополнительной команды
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(w http.ResponseWriter, r *http.Request, params PostTeamRemoveMemberParams)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(w http.ResponseWriter, r *http.Request, params PostTeamRenameParams)
+	// Назначить роль участнику команды
+	// (POST /team/setMemberRole)
+	PostTeamSetMemberRole(w http.ResponseWriter, r *http.Request, params PostTeamSetMemberRoleParams)
+	// Переместить команду в иерархии
+	// (POST /team/setParent)
+	PostTeamSetParent(w http.ResponseWriter, r *http.Request, params PostTeamSetParentParams)
+	// Иерархия команд в виде дерева
+	// (GET /team/tree)
+	GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams)
+	// Изменить настройки команды (незаданные поля не меняются)
+	// (POST /team/updateSettings)
+	PostTeamUpdateSettings(w http.ResponseWriter, r *http.Request, params PostTeamUpdateSettingsParams)
+	// Удалить отсутствие
+	// (DELETE /users/absence)
+	DeleteUsersAbsence(w http.ResponseWriter, r *http.Request, params DeleteUsersAbsenceParams)
+	// Запланировать отсутствие (на это время пользователь не назначается ревьювером)
+	// (POST /users/absence/add)
+	PostUsersAbsenceAdd(w http.ResponseWriter, r *http.Request, params PostUsersAbsenceAddParams)
+	// Получить отсутствия пользователя
+	// (GET /users/absence/list)
+	GetUsersAbsenceList(w http.ResponseWriter, r *http.Request, params GetUsersAbsenceListParams)
+	// Изменить отсутствие (незаданные поля не меняются)
+	// (POST /users/absence/update)
+	PostUsersAbsenceUpdate(w http.ResponseWriter, r *http.Request, params PostUsersAbsenceUpdateParams)
+	// Получить пользователя с текущей нагрузкой
+	// (GET /users/get)
+	GetUsersGet(w http.ResponseWriter, r *http.Request, params GetUsersGetParams)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Выдать токен пользователя для X-Actor-Token
+	// (POST /users/issueToken)
+	PostUsersIssueToken(w http.ResponseWriter, r *http.Request, params PostUsersIssueTokenParams)
+	// Список пользователей с фильтрами (постранично)
+	// (GET /users/list)
+	GetUsersList(w http.ResponseWriter, r *http.Request, params GetUsersListParams)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
-	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params PostUsersSetIsActiveParams)
+	// Перевести пользователя в другую команду
+	// (POST /users/transfer)
+	PostUsersTransfer(w http.ResponseWriter, r *http.Request, params PostUsersTransferParams)
+	// Изменить профиль пользователя (незаданные поля не меняются, команда не затрагивается)
+	// (POST /users/update)
+	PostUsersUpdate(w http.ResponseWriter, r *http.Request, params PostUsersUpdateParams)
+	// Приём webhook-событий pull_request от GitHub
+	// (POST /webhooks/github)
+	PostWebhooksGithub(w http.ResponseWriter, r *http.Request, params PostWebhooksGithubParams)
+	// Приём webhook-событий Merge Request Hook от GitLab
+	// (POST /webhooks/gitlab)
+	PostWebhooksGitlab(w http.ResponseWriter, r *http.Request, params PostWebhooksGitlabParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// Выгрузка данных для аналитики в CSV или Parquet
+// (GET /export/{dataset})
+func (_ Unimplemented) GetExportDataset(w http.ResponseWriter, r *http.Request, dataset GetExportDatasetParamsDataset, params GetExportDatasetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сопоставления пользователей и команд хостинга кода
+// (GET /integrations/mappings)
+func (_ Unimplemented) GetIntegrationsMappings(w http.ResponseWriter, r *http.Request, params GetIntegrationsMappingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сопоставить команду на хостинге кода команде
+// (POST /integrations/setTeamMapping)
+func (_ Unimplemented) PostIntegrationsSetTeamMapping(w http.ResponseWriter, r *http.Request, params PostIntegrationsSetTeamMappingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сопоставить логин на хостинге кода пользователю
+// (POST /integrations/setUserMapping)
+func (_ Unimplemented) PostIntegrationsSetUserMapping(w http.ResponseWriter, r *http.Request, params PostIntegrationsSetUserMappingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить ревьюверов из команды автора
 // (POST /pullRequest/create)
 func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Зафиксировать решение ревьювера по PR
+// (POST /pullRequest/decide)
+func (_ Unimplemented) PostPullRequestDecide(w http.ResponseWriter, r *http.Request, params PostPullRequestDecideParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Пометить PR как MERGED (идемпотентная операция)
 // (POST /pullRequest/merge)
 func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
@@ -199,48 +1695,2121 @@ func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Reque
 
 // Переназначить конкретного ревьювера на другого из его команды
 // (POST /pullRequest/reassign)
-func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Создать команду с участниками (создаёт/обновляет пользователей)
-// (POST /team/add)
-func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// Состояние SLA ревью по каждому ревьюверу (учитываются только рабочие часы ревьювера)
+// (GET /pullRequest/sla)
+func (_ Unimplemented) GetPullRequestSla(w http.ResponseWriter, r *http.Request, params GetPullRequestSlaParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Получить команду с участниками
-// (GET /team/get)
-func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+// Равномерность распределения ревью внутри команд
+// (GET /stats/fairness)
+func (_ Unimplemented) GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика назначений ревьюверов по пользователям и командам
+// (GET /stats/reviewers)
+func (_ Unimplemented) GetStatsReviewers(w http.ResponseWriter, r *http.Request, params GetStatsReviewersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Скорость работы команды с PR
+// (GET /stats/team)
+func (_ Unimplemented) GetStatsTeam(w http.ResponseWriter, r *http.Request, params GetStatsTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить подписку вместе с журналом её доставок
+// (DELETE /subscriptions)
+func (_ Unimplemented) DeleteSubscriptions(w http.ResponseWriter, r *http.Request, params DeleteSubscriptionsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Получить PR'ы, где пользователь назначен ревьювером
-// (GET /users/getReview)
-func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	w.WriteHeader(http.StatusNotImplemented)
+// Подписать HTTP endpoint на события
+// (POST /subscriptions/add)
+func (_ Unimplemented) PostSubscriptionsAdd(w http.ResponseWriter, r *http.Request, params PostSubscriptionsAddParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал доставок, от новых к старым
+// (GET /subscriptions/deliveries)
+func (_ Unimplemented) GetSubscriptionsDeliveries(w http.ResponseWriter, r *http.Request, params GetSubscriptionsDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить подписки
+// (GET /subscriptions/list)
+func (_ Unimplemented) GetSubscriptionsList(w http.ResponseWriter, r *http.Request, params GetSubscriptionsListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Повторить доставку
+// (POST /subscriptions/redeliver)
+func (_ Unimplemented) PostSubscriptionsRedeliver(w http.ResponseWriter, r *http.Request, params PostSubscriptionsRedeliverParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить пустую команду
+// (DELETE /team)
+func (_ Unimplemented) DeleteTeam(w http.ResponseWriter, r *http.Request, params DeleteTeamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить существующего пользователя в ещё одну команду
+// (POST /team/addMember)
+func (_ Unimplemented) PostTeamAddMember(w http.ResponseWriter, r *http.Request, params PostTeamAddMemberParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Архивировать команду (участники деактивируются, история сохраняется)
+// (POST /team/archive)
+func (_ Unimplemented) PostTeamArchive(w http.ResponseWriter, r *http.Request, params PostTeamArchiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добрать ревьюверов на открытые PR команды
+// (POST /team/backfillReviewers)
+func (_ Unimplemented) PostTeamBackfillReviewers(w http.ResponseWriter, r *http.Request, params PostTeamBackfillReviewersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Массово деактивировать участников команды и переназначить их открытые ревью
+// (POST /team/deactivateMembers)
+func (_ Unimplemented) PostTeamDeactivateMembers(w http.ResponseWriter, r *http.Request, params PostTeamDeactivateMembersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список команд со статистикой (постранично)
+// (GET /team/list)
+func (_ Unimplemented) GetTeamList(w http.ResponseWriter, r *http.Request, params GetTeamListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Убрать пользователя из дополнительной команды
+// (POST /team/removeMember)
+func (_ Unimplemented) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request, params PostTeamRemoveMemberParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать команду
+// (POST /team/rename)
+func (_ Unimplemented) PostTeamRename(w http.ResponseWriter, r *http.Request, params PostTeamRenameParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Назначить роль участнику команды
+// (POST /team/setMemberRole)
+func (_ Unimplemented) PostTeamSetMemberRole(w http.ResponseWriter, r *http.Request, params PostTeamSetMemberRoleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переместить команду в иерархии
+// (POST /team/setParent)
+func (_ Unimplemented) PostTeamSetParent(w http.ResponseWriter, r *http.Request, params PostTeamSetParentParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Иерархия команд в виде дерева
+// (GET /team/tree)
+func (_ Unimplemented) GetTeamTree(w http.ResponseWriter, r *http.Request, params GetTeamTreeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить настройки команды (незаданные поля не меняются)
+// (POST /team/updateSettings)
+func (_ Unimplemented) PostTeamUpdateSettings(w http.ResponseWriter, r *http.Request, params PostTeamUpdateSettingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить отсутствие
+// (DELETE /users/absence)
+func (_ Unimplemented) DeleteUsersAbsence(w http.ResponseWriter, r *http.Request, params DeleteUsersAbsenceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Запланировать отсутствие (на это время пользователь не назначается ревьювером)
+// (POST /users/absence/add)
+func (_ Unimplemented) PostUsersAbsenceAdd(w http.ResponseWriter, r *http.Request, params PostUsersAbsenceAddParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить отсутствия пользователя
+// (GET /users/absence/list)
+func (_ Unimplemented) GetUsersAbsenceList(w http.ResponseWriter, r *http.Request, params GetUsersAbsenceListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить отсутствие (незаданные поля не меняются)
+// (POST /users/absence/update)
+func (_ Unimplemented) PostUsersAbsenceUpdate(w http.ResponseWriter, r *http.Request, params PostUsersAbsenceUpdateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить пользователя с текущей нагрузкой
+// (GET /users/get)
+func (_ Unimplemented) GetUsersGet(w http.ResponseWriter, r *http.Request, params GetUsersGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выдать токен пользователя для X-Actor-Token
+// (POST /users/issueToken)
+func (_ Unimplemented) PostUsersIssueToken(w http.ResponseWriter, r *http.Request, params PostUsersIssueTokenParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список пользователей с фильтрами (постранично)
+// (GET /users/list)
+func (_ Unimplemented) GetUsersList(w http.ResponseWriter, r *http.Request, params GetUsersListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params PostUsersSetIsActiveParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Перевести пользователя в другую команду
+// (POST /users/transfer)
+func (_ Unimplemented) PostUsersTransfer(w http.ResponseWriter, r *http.Request, params PostUsersTransferParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменить профиль пользователя (незаданные поля не меняются, команда не затрагивается)
+// (POST /users/update)
+func (_ Unimplemented) PostUsersUpdate(w http.ResponseWriter, r *http.Request, params PostUsersUpdateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Приём webhook-событий pull_request от GitHub
+// (POST /webhooks/github)
+func (_ Unimplemented) PostWebhooksGithub(w http.ResponseWriter, r *http.Request, params PostWebhooksGithubParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Приём webhook-событий Merge Request Hook от GitLab
+// (POST /webhooks/gitlab)
+func (_ Unimplemented) PostWebhooksGitlab(w http.ResponseWriter, r *http.Request, params PostWebhooksGitlabParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetExportDataset operation middleware
+func (siw *ServerInterfaceWrapper) GetExportDataset(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "dataset" -------------
+	var dataset GetExportDatasetParamsDataset
+
+	err = runtime.BindStyledParameterWithOptions("simple", "dataset", chi.URLParam(r, "dataset"), &dataset, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dataset", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportDatasetParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pseudonymize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pseudonymize", r.URL.Query(), &params.Pseudonymize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pseudonymize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExportDataset(w, r, dataset, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetIntegrationsMappings operation middleware
+func (siw *ServerInterfaceWrapper) GetIntegrationsMappings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetIntegrationsMappingsParams
+
+	// ------------- Required query parameter "provider" -------------
+
+	if paramValue := r.URL.Query().Get("provider"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "provider"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "provider", r.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIntegrationsMappings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostIntegrationsSetTeamMapping operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsSetTeamMapping(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostIntegrationsSetTeamMappingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostIntegrationsSetTeamMapping(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostIntegrationsSetUserMapping operation middleware
+func (siw *ServerInterfaceWrapper) PostIntegrationsSetUserMapping(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostIntegrationsSetUserMappingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostIntegrationsSetUserMapping(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestDecide operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestDecide(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestDecideParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestDecide(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestSla operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestSla(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestSlaParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestSla(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsFairness operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsFairness(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsFairnessParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "gini_threshold" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gini_threshold", r.URL.Query(), &params.GiniThreshold)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gini_threshold", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsFairness(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsReviewers operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsReviewers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsReviewersParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsReviewers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatsTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSubscriptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSubscriptionsParams
+
+	// ------------- Required query parameter "subscription_id" -------------
+
+	if paramValue := r.URL.Query().Get("subscription_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "subscription_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "subscription_id", r.URL.Query(), &params.SubscriptionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscription_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSubscriptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSubscriptionsAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsAdd(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSubscriptionsAddParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSubscriptionsAdd(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptionsDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsDeliveriesParams
+
+	// ------------- Optional query parameter "subscription_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "subscription_id", r.URL.Query(), &params.SubscriptionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscription_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionsDeliveries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptionsList operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionsList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsListParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionsList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostSubscriptionsRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostSubscriptionsRedeliver(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSubscriptionsRedeliverParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSubscriptionsRedeliver(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTeam operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTeamParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTeam(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamAddParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAddMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAddMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamAddMemberParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAddMember(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamArchive operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamArchive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamArchiveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamArchive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamBackfillReviewers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamBackfillReviewers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamBackfillReviewersParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamBackfillReviewers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamDeactivateMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDeactivateMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamDeactivateMembersParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamDeactivateMembers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamList operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamListParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRemoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRemoveMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamRemoveMemberParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRemoveMember(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamRenameParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRename(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetMemberRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamSetMemberRoleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetMemberRole(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetParent operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetParent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamSetParentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetParent(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamTree operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamTree(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamTreeParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_archived", r.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_archived", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamTree(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamUpdateSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamUpdateSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamUpdateSettingsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamUpdateSettings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUsersAbsence operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersAbsence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteUsersAbsenceParams
+
+	// ------------- Required query parameter "absence_id" -------------
+
+	if paramValue := r.URL.Query().Get("absence_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "absence_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "absence_id", r.URL.Query(), &params.AbsenceId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "absence_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersAbsence(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersAbsenceAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAbsenceAdd(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersAbsenceAddParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersAbsenceAdd(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersAbsenceList operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersAbsenceList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersAbsenceListParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_past" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_past", r.URL.Query(), &params.IncludePast)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_past", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersAbsenceList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersAbsenceUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAbsenceUpdate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersAbsenceUpdateParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersAbsenceUpdate(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGet operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// Установить флаг активности пользователя
-// (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+// PostUsersIssueToken operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersIssueToken(w http.ResponseWriter, r *http.Request) {
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var err error
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersIssueTokenParams
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
+		siw.Handler.PostUsersIssueToken(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -250,11 +3819,72 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// GetUsersList operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersListParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", r.URL.Query(), &params.IsActive)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "is_active", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "search", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.GetUsersList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -264,11 +3894,56 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetIsActiveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.PostUsersSetIsActive(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -278,11 +3953,56 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// PostUsersTransfer operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersTransferParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
+
+	}
+
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.PostUsersTransfer(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -292,31 +4012,56 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// PostUsersUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUpdate(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params PostUsersUpdateParams
 
-	// ------------- Required query parameter "team_name" -------------
+	headers := r.Header
 
-	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+	// ------------- Optional header parameter "X-Actor-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Actor-Token")]; found {
+		var XActorToken ActorTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Actor-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Actor-Token", valueList[0], &XActorToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Actor-Token", Err: err})
+			return
+		}
+
+		params.XActorToken = &XActorToken
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
-		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
-		return
+	// ------------- Optional header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken AdminTokenHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Admin-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Admin-Token", Err: err})
+			return
+		}
+
+		params.XAdminToken = &XAdminToken
+
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.PostUsersUpdate(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -326,31 +4071,60 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+// PostWebhooksGithub operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksGithub(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	var params PostWebhooksGithubParams
 
-	// ------------- Required query parameter "user_id" -------------
+	headers := r.Header
 
-	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+	// ------------- Required header parameter "X-GitHub-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Event")]; found {
+		var XGitHubEvent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-GitHub-Event", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Event", valueList[0], &XGitHubEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-GitHub-Event", Err: err})
+			return
+		}
+
+		params.XGitHubEvent = XGitHubEvent
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		err := fmt.Errorf("Header parameter X-GitHub-Event is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-GitHub-Event", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
+	// ------------- Optional header parameter "X-Hub-Signature-256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Hub-Signature-256")]; found {
+		var XHubSignature256 string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Hub-Signature-256", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Hub-Signature-256", valueList[0], &XHubSignature256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Hub-Signature-256", Err: err})
+			return
+		}
+
+		params.XHubSignature256 = &XHubSignature256
+
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
+		siw.Handler.PostWebhooksGithub(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -360,11 +4134,60 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+// PostWebhooksGitlab operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksGitlab(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWebhooksGitlabParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Gitlab-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Event")]; found {
+		var XGitlabEvent string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Gitlab-Event", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Event", valueList[0], &XGitlabEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Gitlab-Event", Err: err})
+			return
+		}
+
+		params.XGitlabEvent = XGitlabEvent
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Gitlab-Event is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Gitlab-Event", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "X-Gitlab-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Token")]; found {
+		var XGitlabToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Gitlab-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Token", valueList[0], &XGitlabToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Gitlab-Token", Err: err})
+			return
+		}
+
+		params.XGitlabToken = &XGitlabToken
+
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
+		siw.Handler.PostWebhooksGitlab(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -487,27 +4310,138 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export/{dataset}", wrapper.GetExportDataset)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/integrations/mappings", wrapper.GetIntegrationsMappings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/setTeamMapping", wrapper.PostIntegrationsSetTeamMapping)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/integrations/setUserMapping", wrapper.PostIntegrationsSetUserMapping)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/decide", wrapper.PostPullRequestDecide)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/sla", wrapper.GetPullRequestSla)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/fairness", wrapper.GetStatsFairness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/reviewers", wrapper.GetStatsReviewers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/team", wrapper.GetStatsTeam)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/subscriptions", wrapper.DeleteSubscriptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions/add", wrapper.PostSubscriptionsAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/deliveries", wrapper.GetSubscriptionsDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/subscriptions/list", wrapper.GetSubscriptionsList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/subscriptions/redeliver", wrapper.PostSubscriptionsRedeliver)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/team", wrapper.DeleteTeam)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/addMember", wrapper.PostTeamAddMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/archive", wrapper.PostTeamArchive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/backfillReviewers", wrapper.PostTeamBackfillReviewers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/deactivateMembers", wrapper.PostTeamDeactivateMembers)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/list", wrapper.GetTeamList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/removeMember", wrapper.PostTeamRemoveMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setMemberRole", wrapper.PostTeamSetMemberRole)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setParent", wrapper.PostTeamSetParent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/tree", wrapper.GetTeamTree)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/updateSettings", wrapper.PostTeamUpdateSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/absence", wrapper.DeleteUsersAbsence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/absence/add", wrapper.PostUsersAbsenceAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/absence/list", wrapper.GetUsersAbsenceList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/absence/update", wrapper.PostUsersAbsenceUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/get", wrapper.GetUsersGet)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/issueToken", wrapper.PostUsersIssueToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/list", wrapper.GetUsersList)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/transfer", wrapper.PostUsersTransfer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/update", wrapper.PostUsersUpdate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/github", wrapper.PostWebhooksGithub)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/gitlab", wrapper.PostWebhooksGitlab)
+	})
 
 	return r
 }
@@ -515,42 +4449,291 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xabW8bxxH+K4ttgTgALVK0XaD8xtiKqg+WWYoGigqCsOKtpEvuzXd7agSDgF7Suq2M",
-	"qvlWBEiMwH+ApsWK1gv1F2b/UTG7R94deTxRpiwX+WJTx73d2dlnnnlmli9p07U91+GOCGjlJfWYz2wu",
-	"uK/+anBmLzOb/zHk/i4+MHjQ9E1PmK5DKxTewiX04AzacC5fwyX0oUugBxfymMAZ9OEC2nAJJ/KIFqiJ",
-	"b7xQExWow2xOK1RwZq+rzwXq8xeh6XODVoQf8gINmtvcZrio2PVwcCB809mirVaBPg+4v2RMsuo/cAJd",
-	"uJQH0JPfa/vkAfTlHoEr6CtTT6EPHfW4C+fyeIJ5YcD9ddO4kXGtwZfKgQu+7/p1HniuE3B8wL9jtmfp",
-	"j/gdfmi6Bk6x/Kyx/vWz58tPaIHaPAjYFj71eeCGfpMTxxVk0w0dQ3nA812P+8LkQWqq9GM98UvKndCm",
-	"lVXaWKg+XV/409JKY4UWaK2e+vx0ob64gGujHdWVlaXF5ejP9cfV5SdLT6qNhehbbeVaYXTzCbuzTi12",
-	"4qo2LR4fz+VufMObYmy83uH4sAKthZZV5y9CHohxD7AgMLccbqz7fMfkf4lgncZLdMoELqENp/ivfIX4",
-	"gUt5JP9K5B50oSNfy39BB7pyD5FD7pXm5spfImwEt4OM7Q4NZb7PdvFvFoptV8Epa3TT50xwo6r2sOn6",
-	"NhO0Qg0m+H1hqvhwQstiGwgdDcEM3/tbs83ghZa17mtfTjI0NUbHScaoQDARBknsPastLNMCjVA2jp2R",
-	"8x41JWvhpE+HSxayzvwa3Kxsu34WeHJP7NfgrCy/IOeP+8Lm9kYUPUPI/9bnm7RCf1OMU0gx4r4izvJU",
-	"vZMVCzHvX0sUyRQxMGKS2dGCY8abwTprCnMnudyG61qcOfjqgOazzga/m87QOFkM3ykkVs6yGdPYja3N",
-	"890n3UvyJPL2hZOZzqarljEF8g2t1Uk9ikZSVfFpc0eQFe7vmE1O7jV4IEiDBd8WyNfMski5VH6E9LrD",
-	"/UCz9Pxcaa6Eu3A97jDPpBX6YK409wDBzsS28lzRi2O6qBlVudfVqQGdzJD0lww0yQ1EggMe6+HaDzwQ",
-	"X7nGrs6hjuCOep95nmU21QzFbwK0KpXPE3RBw3mawRDU8+/Pl0rzmQFaoVXDIAFnfnObtpIS43Ow0owM",
-	"k42KtIpSD7QyUhsrl+Zv5nDPn5TiV2lYRvA+QENu81xistYc3co5KG1eHkkmpUurlemytFSp1Ynchz6c",
-	"wgkqazzMh6WHU3gttjHPnrRazVgf/g0dLaWLSX0PbRRPXa2gPkTq+0hb9/ubnemoKE6K1FgU1+rENAiz",
-	"fM6MXcK/MwMRjJzFTPtEPx/Cf6FL5L48lP+ArtyXB9CRh9CVB3ql0LYZlh8UfhmciDyQr0mtTqBHoK09",
-	"hS5ShcgrnAPO8Kukzuypd+AE+qScLTWhB6cj1dRwdrkHbWRmtqVAn8BTQNfQyhQjKoE4NSE+VaNn4MPJ",
-	"YZYXNNfy1zXM9HHMU7ob5oklOsUMd3++dL/8sDFfrjx4WHn0uz/fGjdFwvHu2Qk6iqBUtPTlsWoP9MjA",
-	"nDtmK7RnlJZGY/eNiquuCtFB9J5BG84io8k96Kk3L+AK+qpfcCkPVPAeE+jDlYrTtvwb9OTxl9PHos81",
-	"eqYOx/rghRki0rVirEaoLOdiLgc/OFee2Jw5kAupJT5/WKPSDB99ckGBe/As1uTG+gYiNHxEby+KRybP",
-	"6YT0oQN9eA/98aSkMk7+Ufo0vdLaFOwBb9Ts3bE2TA9DuCOPdPMOIxrt+yxs0oNzTODZXcTXWWxzQwkU",
-	"1dqYJZTpMVH9pNeAU+SdC0VDx1o7IC/twzl0ybCFt8OscJKcGg6K5VSTOY4ryICTiOsQbQOp1bUrHPcx",
-	"cwzTiCqqtF3yQAkYJH15CFdR30zRKIrDnpZG6Ks800b6jLF1jkt0rUkiSKnSsTmwh5gOwdJ0YKioRvE7",
-	"Yuib3EN7J4/gfKwFmKXILvI3keqdJtu4UfVrBqqTOyAZIlwits0g8vTtSVj4CdpyTx7Kv8dBdKKT3bC1",
-	"CVcYztBBXJMolWXEnzwez5rjQyMli0L1Es7wa5UnJ5GI8jWBE7QRh6hhWut29efRC4SczIrnX2SGkZ9N",
-	"G5zZVcOYJYMO22CrqT6N7qcO06HOCnG7hVYts8lpq5D/Ujn90lfuBm2tpRo+1GO7trqqmRoojWFo3HL1",
-	"LaI+4ed2yQZrfsujW5FJaXJg6xSOmiZT/ZgqfZMVObQ15Zdmq3rTFzUxiwz3/Qlr39HdfWwdnIrfQyL3",
-	"iTyUr6CtZhhcG15Aj9yLHSh/kAdF6MO7SIGcy2OdXjIzLnThQ1Jy4wmmGGGLK+dH/6UJYZErPljkQnUQ",
-	"41vP1Wz/xUOK6VtRxONssvP/JoJuzikj0PkZ3sl/QhfO8MzS/H3nraof8/tTGKnjpeC5wmjvRgCegEB0",
-	"eoAQ1I3vPCA+x6GLw5E3xWPyNnx2NCYLFr38J6131kbQOmVvaPqbqLF7voz7qMmF7MSLkbQxUxU4v8AV",
-	"9BTZnZFa/Qvd3Zv0i4RrwFmrfyGPCgTeI5pzK5KpBO0AwAqJKQAHXCwF1eF91GR1pV5dSYyeQWYlCG2T",
-	"WQGfHiMffdU38aCvu+q65R5EGN0Jjrsgi7KvpfocVw1WygsedUM5nSj6OZG1f9AlBnyYiMy7zwdvpi/a",
-	"06H3VhF+O9qcDj/5PZxDG94TaGOygx508Hs1spf3M6OxQGsNn70c/OxIZxFM7NEDPTjxIFUCJZ7/gTNL",
-	"bNPWWut/AQAA//80B9xs2CUAAA==",
+	"H4sIAAAAAAAC/+y9bXPb1rU/+lVw8b93jjUXkinZzoM8faFISqxTWdKh5KRtlOFAJGyxpgCWAB27Hs9Y",
+	"UtOk125cd3puz/SeNH2YO311Z2hZjGlZor8C8I3urLX2Bvbe2ABB6sF2jt4kFglu7Ie11/P6rftm1dtq",
+	"eq7jBr45fd9s2i17ywmcFv41s+E7btVZqP1H22ndg09qjl9t1ZtB3XPNaTP8r3A/7IZH0U7Yi34T9sKD",
+	"sBPthP3ooRH2o51oO9rF/+6Ee2EvemJaZh1+9isczTJde8sxp02b3lKp10zLbDm/atdbTs2cDlptxzL9",
+	"6qazZcOrb3qtLTswp826G7x32bTM4F7ToT+dW07LfPDAMmeqgdda82477jXHrjktzZT/EfbDA5i0Eb4O",
+	"++Gr6HH4IuyHezjzbvgqemIZ4V70iL4Nj6In0bfR78Ju+DzsG7jcl3xFYZc9uh92wqPwKHoUvjSir8Nu",
+	"9DDshi+Mi23fafkX677fdnBSE+uu+v798HXYi7ZhACPaDrvhAfwYNjE8NGZm15bLlbXln84vVVbnZ8vz",
+	"a0bYkyYR7cLD8FHfgHFgDTCD8Ag23Ii2o4f4vs7VdZe9bx9X+Zimaxnha3iEHmS/ZAvZSWbaC1+FPemT",
+	"Z7jC8CjswEvxLfjTsI8bJS2lg9QQHoSvwj5sKHwYbeP0jMulyYl1l1PGJp1aTBo/G8cTHcfdM0VqYIfv",
+	"B626e4vOvrZVdwuffSfcDw/DHu4TLYBRbtgxLoSv2RkeskV1oicG/DZ6GO2GP8TbOzN3fWGJzmdswgj/",
+	"DvvRCw9TZGLAsOEL3I5v8Nf9dZdvIe5/uIdvPIBdfoiE17sKo3XZF+kjGX1HYZ8K7ehsu+V7ray7/5do",
+	"N3oYbeNtd527QaWKjwOxsFV1w324HNFucn/6uCVIFBncgEYZMLPF+lY9yJrY32Cv4eiihwY/Wzyw30aP",
+	"Ml7agPGkd9acm3a7EZjTV0qWuWXfrW+1t8zpqRL8VXfpr0ktD1pu1ZzMTfsu7ODWdMK98BXREZAH7iJy",
+	"UaKFg7CXMVOvRcepmalp+1XTMh0X5vY5+wveb35hafZwpeXdqedM9f9FrrCDd+S5ER4g88g6tSYbLJeD",
+	"/+8t56Y5bf6vi4nMuUjf+hf5bHBqq4Ed+B+3vK2cbYy+DjtA/ga7rD2an3EBt+9V9G30ddhjLP0x3Di8",
+	"oUB4uM+0soOwAyRCT3biWwScEi4x3T+4e4fAMZ4jTT/HETrhoXHhxtrsmEWMNfo98exol5hiPDvONZ6D",
+	"aEnesIdH/4Jx7uQXOJ9duujCNVa2+2bL2zK1wrFmB854UN9yTN2Z48aueZlXGrhJ2I1+m97Uo7Br5O3s",
+	"X7OWifwr+kq7ULwCwB9Awvair4S10+aCwPyDuO/RI9jRZ/BWuEEonBnn+03YZx/3w5dG+AJZfAdF2ksU",
+	"m4cgm2B8rpzs4DWEUR/jzIB1HaEEfQifh6/W3dW1mbXVSnl5cfHGSmVhaW2+/OnMYva5BN5Ip9LeiI9h",
+	"JF1LVCSyWYcvvOYklK01x95asrecrAn/E0n8IOxwOgES6oWH0RPiJofIlvczmXLg2FsV/HfeTNPbecN3",
+	"WiNvo0YfzJgeKHeDtlGd3AP+pahewz+bLa/ptIK6g18IGnGRswCeX/MrdiA9nUNzMGXb91zNFOkrv37L",
+	"rXhuxQ/sVqDZxO+ZfnSEopYudY/uEeol0cPoEVxWUn264V70OPo2c4ONcI9u5yGdjMwmMiwJNu8Nz2s4",
+	"titO3KlVbN2k/4K6KbAzcU7Pokeo3IavNWuC6QB5ajfVbTca9kbD4cee2kncveHOhROVlrATOvtcNpoS",
+	"UkxemRBFfNq6s01UA2/jl041gEnMtVs2bNqK06o6blBvOH6aRqte29Xt8t/DA3bKB2HfWCkb0W70NZ70",
+	"UdjXEm/zSqniO1XPrfnyRnntjUbORrvtrQ02wofHH+HDY42gnA5tjrw0eZryK3WnMN9qea2y4zc910ce",
+	"4dy1t5oN+id8R6dQg18tLa9VPl6+sTRnWuaW4/v2Lfi05fheu1V1DNcLjJte263hROVzjIdSj7dGb2La",
+	"5Nr8zPXK/M8WVtdWTctcKUv/vj5f/mR+jv49u7i8iv/GDysfLS7P/hT/hjnOrK4ufLLE/qzMzizNLczN",
+	"rM2blrSC6/PXP5ovV2aXlz5eXJhdMy3z2sxqZXllfqlSnv90Yf4zeC1OaKY8e23h0/k5/jeMMn99Ze3n",
+	"pmUuLH06s7gwV1lYWrkBY9xYmrmxdm25vPALfP7j5fJHC3Nz80sa7dgyq557s1GvBr6Gwv+EPEuZpDGO",
+	"hE7mMBN8PQuNoXHQRVDkkaCJHkVfkX7CTG/ZmHsaHpmWWQ+cLX+Q0nzdAeqbZXM1H8QLsVst+575QCCG",
+	"QfwEzzt5Pk2QyvNENlq6vRs4LddugHqQpiuHfVsJ2NcaJs0Ug7BDWln0lWiJhN3YFjEuhPt4Fp/Ug2vt",
+	"DWPc8BvtW5aRfLpow6exKwds6PA1ipLHBqiV0W74OnwdPRrT8eLYpilsuliCzjJwxwWTSd4UcZS8DQY9",
+	"J73BDe9W3dVs7P+D0q8XHg3eVHJMIN9+iu4bpn/HnpIT267C4k7YLFpg8lvdFi16dm2uDmNttGkD1G26",
+	"VXfrevKLfh/9BjTD6Ldhj+sjfwp/IF+RZZSAoI7IGox2wxdkQ4L7aJs7PUCrIUUCSY/Z9EfRrmlpJEtK",
+	"Fm3ZdytbdbeCQlgzx78ig+lH38T+A7wxB9E2aNeCuv1SnWjPCA8M7vaSH7UMkHBG2EWrrCc8Fe2GhwZz",
+	"WaDn6SjaBStQu5iBgnbLsV29hNU8Cv/yB+oYKb7bD/cGax1+UKvUnDuF5qIQI58YW00ylkVkpR6hjkIV",
+	"1p2Wv+1Wy3GDisRQUu5M8LGCd62j2FSM8rTKNmeay2vX5ssVEJtj+RaC/NbkZ8Z41kseG+QmJcfWNlr0",
+	"PbhHe0a4z9wESJ7ilLuWMXdjZXFhdmZt3hg32PWmV+wxySl6FiQRGnYFz1cyRdMy4zG1cr4wAxKtPdyY",
+	"7EMF5qOx6VDx3uKBljRB2nfsOt6dir9ptxyt4tHHk5OdMxZtI7NslLvA7BsD+QMwe3aHewbz6GjMq7A3",
+	"YYR/NOzaL9t+4NTW3RS7Yyyux88CBcrvox1wfu3jJL+9qlGHwDtN35NPhsy9x8DIjNLEJE0Jf9ZD4xEI",
+	"irl30ONSgGfU/YpdDep3RPkrmIgjHHcyoiWdYfrAdBSxIghFTp636sFmewOZRdCwN7R0udJuNMrOr9qO",
+	"H2SRklOrtJw7dedLLY+Mr49izoIWBI622AJmAQbgmczL94zuNB4Lf0MFLRrFbwNUtcejJmOi0ppaj6qX",
+	"2u1g08s4CsusNjzfqc3kG/ErZeIA6GwgmUWKy5bTuuWMbLRXW44d8JePNsTNessP5pxq3a977oBV4LXs",
+	"j8N/KHKiPZlwj935PfSpINfrYeDnlSFElXrIBkebNO7asZbdbDcalRYRbdbJSs9k6MnoxQjavnhnwPjj",
+	"NiVYb8zK1F2dPIkpWReWYJOROGLbip5j4FE/oNQCQuvR4ag/gON4hlGbTuKIVg4QHUgDtFtl53T7JN6Z",
+	"eIcsHS/Q8qGEn6xuei0dU8m9kW/F2Z7Uruk2qIzb95FdvX2z3mhotqdWK8hw9zFAkQT4shnuUByzyAkM",
+	"Y32mNi/5sZVabfaOlZ1EKKZ3zXW+jEdhk84UUxS5weQBda86KevkgF3iHsZ4dpg2Azf3Jfn3izAsr1FT",
+	"Z5d+ph1UPR0vKc+vLM7Mzs+B4wcm1YdZcB8yJoQYyuqvZs+fXB3daMcSBgO1dg+JqR/+EO6DY8i4sDJT",
+	"/un83Ni6y3MydsNnFOA2LpTnry9/Oj83xuKXYm7GS0gbUMW361WqtlurA5evNL1GvXoP9Sx+MfkSTctk",
+	"Q4OLDyeg5byDSXQgEapHkhyAjgT1Lia7Vd2s38mKAvyREjtAbe1AYBJ14g4a+z1OZmQgyXk2LAvoZTp2",
+	"Naq8ja3bQr4+WCvZFzru4N1xWq16zSk0zKoTBHX3lr8c/wgOzx5ob/4NDY449IvBxrTlqWyiojGycDBQ",
+	"O9313dg98qRQTIXNfZh1DskaRUbITymL+j626y3X8X2dwCDzadBEU64qsGK2NuyG7VYdDcfk406Aq4Hn",
+	"2exFj6JvKIEhcTk910bJhqU7wabV0F3L/nKUBR7zNOi9VrLH0pZlnRW7PamTGmAztryGM2iNMHwZnsu1",
+	"Mem7YstODND4N6Itmr9Gf7PeTK+z2apv2RQTP/4qRzxBfI0VTyVrGUss+pTL2Ee07zbrjVrLcYdivDgf",
+	"bXhla4Mbx3qvzukw5reTTVZ42DN+u7DdWUdNaiQmJo3gNCOrlasMtYwzaDpupeHZGV+LeQNf2vcGPlR3",
+	"9Y+MuHeyU0mdjPrm9JLF9WVuMrvdGoEO3tpwT3HFThiL8zNz3EP3hOSKqk/2FOFukcaUeBp76DKE3+pc",
+	"9KDC6rMuSI5FX6MTuSflkFzNylcWHwJf5DYlHtEcYtscXoBLg6y9DjP4DSE5VfWEsmCPcX1mYWltZmFp",
+	"vixpyBQENi0TxgQbNn5MqyBLty0dGvWrdgNU8cCr0KXXHNlTylSOHgvrFR1FzIWUVtT6KdUVvRrd6CnP",
+	"HxO+tARTZc9gdkg35bFP2zE4B7RkMpSP1i1uamgW9y9ygx3hwR+Ffa5EXmwm7ouLOMa0Ybv36HB6SAXP",
+	"w75l2E2IEzo1Y5zimztw5M8oZ3CfBuyTfc5orhd2iQyPwh59xxPYpeACZga+oNvAfvfSMuxGoyK8MT20",
+	"QXYc7NEP4T4bOG3cShRlu3Dn+bDwT+EtWqLSWHHZW0uOeyIgTv2HeDO3mQF+mJkJhffhgnLH0XLCmon0",
+	"7WHlEXAU4X7iQEsocGzdhffGNi98Z0mZEhTD7NLPot1kbvu0iGmjabdu4+4L1vI+JaNZ8c3Gw6QkgLDH",
+	"6iXgScgQ11wja91tOVveHQezOsjApgGjXUxpotzRI+KLYhhDN5p0vjBdZOowvPY8xRytmoP6nh04BZPw",
+	"OgWS8OjUNceI7gSFUVM1BNhpmKH3Cr7GTfw2vpxU5eI7wYI/A7N1xjLS80BgVfyGXdn02joX2uriDIsl",
+	"CdPdg786eFhfU44wTjDs6Jxq3Pgc57GA1cWZbKVQEu9inGNwyFnLezUHcUSeHYhgAi2vlM3ct/tOw6ny",
+	"hIXUDF4jkffDZ9zxTOUquslMGy3brXlbzDtFxxq+hKlYxpde63bdvUXHQE9Iedk7Yddad+G2HYjlPC9p",
+	"58Xz6GIMkXtULLxo8PPwkEYxxEEoP5sndx5FT5lQJvcVXSDprtASTMuUJjzYMZxxg7T7nDp6SyeH9VxW",
+	"kWdZyldaq9cXNEi6lRVnsMcKDl2NbSg90ETPr8aUL7PsLlPceK59EkEH4VRQVTCtQrpKxj3LUQAKi72B",
+	"Fk+GGMzkuwMHzObDg5c5mNdBGhHwu+gJloL04xxAKK9gOYCjsK0hfiMxm8F3bsCGPcii/vYW9zooth3K",
+	"ijzb+UTs/QImOphPzWzW/xeqx8PCTlJt+oqEjb4yVsqWLn7YYYUt8l3VCoGTMb2lXVXXpuNQ+izCE/aJ",
+	"5a3tVD1m4k7le89gH1Za3s16wxl6O3CXKdDo1E6Ajp6TcZWVXJWkXWgJCWfDONDpz0UxEnTayOFgek+l",
+	"OG6ziOCRJsJgZkT9fW24Zzsd+Mqp/O6rb2Z5FyAUi6ZlK45Yjd9wRIpXufKAeXxGD1/DZ4e+LrSjcgJU",
+	"mrT0xJ+tsskXbhjn3yCFvINlx0XIFNJedZkaZP2+oOTJOMNOS7wav+OAOpjBV0mdDsaCsYyZlZz3C05O",
+	"8ndm5oyC7o4mFRrfks11FHZ03ECXGi7nIo5lmDcpB2tB68ogkz+jagwUZAp+5xVwYRb3oWkVceoWnVY2",
+	"ndEXmMMYvhplTqcoDE/b0/yZs7HpebfnnEb9jqNV+YLA2Wpm+fJZ5t1Q9XI1etcxdUQ2yr0hCi7vMJMn",
+	"l//SfszDs2swBP+hNhWmXjPIuocbBz61q+RSJIWSZ7WDub0ddllBdVzEDBXT8q8tdJ0Qjkh8zbtSOjWl",
+	"Jum2tWH7QYWd1rF2FgeKS8wGPo44Eifx3qZ9jzPAoidEDCEmBe+mPgM73vIDOYMQogyaZHXMi0aVJUVV",
+	"RUw1qv6rJElz8oSura2tjNOMIPsa8qvJSYTGfpxkIcJupFKphO9YGhWVxPYLTTFrZh/PLCxichRuyWuk",
+	"SgwZ9RBvAbjia3T7PBIcPivzS3MLS5+Ylrl6Y3Z2fn6OKvVwKK2vVC1pL1i5LnJK8e6nBxQuLPunmRCX",
+	"mIbJOZvExnKY5PwdfXznH+ig78u3+wBT8v+efGLU7MAmAbiHV7vHUA0MeN/0ujtuNFsTbCYW/Jt4uTFu",
+	"CJmgV+E57g6Y4LzfGDeUvCzLiPVCyxCysyCARNUQ665hXIhft8GyKDluEHMW1T13TH5ly8l7qZILZqn5",
+	"dMYFIM8kVAWTiLPnQI2PnsCukMqCe0We+kdjlsGyyowLPMfNMliKG5805blZFL45AGaaTngcg1fGm2Bc",
+	"2LLdtt2wjNhdVPdcy9iKTQE+NiveHqND5TybBYwSfBgR12cnUUTwpeR7FwIne0rgpKugEImAHWI4C2cU",
+	"bUeP8WhAW5iIp4/nwlQJAr0QapCBBOH/qaw/s9kanyyVJpNamWl+KUR3Kz7anpKsjmkTaMdxMdcIH7h0",
+	"c7I6ZX/gjF/ZeL82ftkp3Rz/0H5vcrxUnap94FzeeP/m5CXTMr0qFkyRGmBOlaaujE9OjZc+WJssTU9e",
+	"mS6VfpFwghTFm6miaL621O3NyhIVX19Ud6EPhlUhFPZFqbvwjTwLi9YwiAOtsTnEbtLWRPqoko0SPkvu",
+	"LjqHGYNhCqdIQlrGzeYgIp9oauBGUAiRRxfPbtOpaKqxPoqQscx2qzFYTU+LG/hZvIoCwsS53bi3ttny",
+	"2rc2m21NBjY7Fq3C/aXj3M6B+EAsoAQujsrJ4o/CHkH0qAmwA4sdhLdyE0O/NtF7kVoX8Ig8ACMpSMWg",
+	"+46g+vHatenr+orHrJ0QwaYGDAs+4PBoPHwd7ejeAET7a8/Vebz+hTGaGL0ItLgn0baxMLM0Y5H3gpzL",
+	"BGs234bNuHjd86velwN3PH4tXyNig+hq/IHDuTex6DioB8DnzZUyE3xOy5iJJZ+x6rTu1KuOcWHN8QNj",
+	"zfZvW8bHdqNhAO+F7b3jtHxa2+REaaLE/RJ2sw5cfaI0cQk1qWATj/Oic7fptYKL94Fr+U7wAD685QQZ",
+	"5c8Q/X+KUl6CdyPsrTgwmkTPGOCC5K0wPgcULcsIPIYMxqASe0xlZfhR37K6VfGX8MC6ywOfJEaNC6Ig",
+	"hLBqcnctQzC9jXFDwKkBZYEKxeAL+HeNPl93iQlIAwGBQZEn5fhGT8J9RopHzKtJFzSW7qBBELIOBWIp",
+	"urQ7bahzpRUlLwKtBlQucd4Xop3YedTT5DpEj8bgZ8l6LoilaXp/296YMU4Aaqr+R3qVoP2x7bjAwNvg",
+	"iHsspUtxrbwcYysy6jVS/AgaqwdwYn9h2TgdgscUyCfsUCwc46vsHU8StYkQGH+DYfRXWMoKIz9HRzph",
+	"9vXDw6tGtC1SUp8R67cCshxDceyHhzgfAUZRQUSDhCsVwNBADvECaQ+CS51URbQ4o67xs/El524wTlCO",
+	"lpGAEog24st1V35P2E2PxUtUJgx5zgiiFW0L2X4ylpPgQE+tZlraLovxPUwe2ou+ASqj4l/4PHwBblB0",
+	"a2D6Q1yQTNe+q7m2rMo52g73cczn7At1Gsr9F4leJGhr3RWu1tMkMB/vp4JcJU0TWESeP9BKiqC6SlHn",
+	"mEV7ytDrCJ2WEgYeoy6PNdrd6CmNIWxH7IvgFAgk93ej6Tvtmufe26r/2vkJmPYGZvBpcdFYomCPlp2J",
+	"LIDUKlhZT5LSbWa1Pgt78U06hFyjr3CNHfzjAu5/N3wmZCjM/2xlubxWWVmdvzG3vPTz65Wfzv98jOwQ",
+	"UAVswsszp81PnGAe5cccSQ/TkoCMP79PAG4gbRL8tlr8bDZ+W6wWi+wy5UmNCSTR3HQ5Kvf1UI6kPOkR",
+	"Pav+HcE9Qn817dav2k6Q8YqTAMo8WdDJE0OZPEnMRf1ZiNdCfyI37Yavyfh4YJ0yCu1kqVQSgWgnS+yD",
+	"XCzaXMxeysdMc3lJYuiAfF8WXU8BKN8vEicn6oFTpZKJ6GNuwPxjdrPZqFfxrl+849Ym7KZd3XQm+C2Y",
+	"vq87+426a+NMNEkqzt3gItwk6ZcaoEY1FqSoCipzNy2GtYzrkDZxAHhyAal/NZVey0WtXp7n7jks7nLu",
+	"Pv+SIb8Ug+6Vgep0e/edgmOdaOmSjm4hiGv0EDOSdyxF5+6xytlk5/A8fZ5fBLH+RypMiaD/s3rEDr4c",
+	"wUsYKkm4Z8yufhr7/RhhWWZgQ5b+5yaJFvMLeNtFvF0kevyLW3azyXP5mbGSEkwLwg+u8+dT8km3v8kj",
+	"F2Ws5iFvTfo0ZVM6Tp0o5DGR4N0yUhuGHw2zkFKjaYKafpyZoDdf1VuLKmHiSX/FjRHzjVwC0B73WMoN",
+	"Myi+0sNsK6SduY5cbUyu0FHeFXaStyXELpKrjuR9J8D0FqJkJCbP15jpmPAgp6FLvRG+JMeblQW6IZkN",
+	"iaiiioxvBGAFTLyOnoaH0lrHM9L6Exc5MwC1+9pJ1bkIQDdXDZouLIYXHvCMoX74Mu+noHz/J4u7xU5v",
+	"EVuNAhoZs0KbexeZmhAamDB4VBLdAqD+i8GCnE4H0a5Ol17xfIlnrcoHPiznSnVlIOaFmvRHXu1egQso",
+	"4p7K6JEQiUMlfJyHDUQYwgRoKSPAkMUQUxiVJw8NOTiZ9gSgIuVh4EUP9IKjGNPkeG5fMc2ky0NJDIcC",
+	"CZM+Nd+witE1xMx5ms3k2c1GarIR+yD6iOJEOf1QIIezunSGe/QnpStJuD8q46C5Xz7DuadwYWW4l8ES",
+	"k8GTi4mgu4OgUNNZ28WlJGg0A6XkMVi3EUsSjqFzTDlSRBKIi3qzkoDB3Jp2o151xgl8U8f643Q6sz2Z",
+	"x/Nj2NyTxrUdmdMPxrk95+/n/P3Hwt+/z07cljl9QT7/qijcdUYq77f53F4sSKeomcjk03xUyLqapceP",
+	"wfsEqD7galZuCo4Glc+cqdUM34HyqjyWeFaIgIUBGyVbqjh641Ujs4K5n1tnIr3vxEEcR+Pnk8ORSrOV",
+	"BRj7OeVetS+ZX4izOj5FJTmhhOz4IIfEmoOlanJxdDWGaU6C5fkYLUQJcfac7A+cZi6q1KSysugRze7D",
+	"4mcq4SDhrBNOmH1X0EmSoN11Tcu8Yzfa2lYial+NpJ0I3FOj7hvx63H1zt26H/jyVLDrCwMAp6CB6LTO",
+	"e7vYWCR580oZIvl2o+XYtXsGe+ODBzJlHevU8mecOJ+Lb7FGTL3gqBUcUpb9nvzdvBqPfNLpzkrZeC85",
+	"qMyCFBNukk6KUepJjqnyNzEinXBb6iPQjXZSE6TJSS08JwwU9EL9AUfRfUHLEeLHtPHdGDQleTm40QQY",
+	"x8RTqD7KW/mtlCXNVoaRV9Cas3xiwvbN0VYNbQCpzWkfWIN/c6JGEw9UA8NeWSkzRM9sXp/DuZOhkii5",
+	"MObstZmlT+ZXK+X5/7gxv7p2eoCh8TyOYR6NGDcZXnjJll6xCIZ87dIG2lsQyNBkrJy5SQazUvF3JdZj",
+	"Sd1zeRqrkh4vdjsewsg7Q/VipUxTkU2iolrEKUhLsUI1lpOv86y5lJxgvVOfI6dWBeefw46YYsYHjB4r",
+	"FGekwYA48y8uBKl1QLYM/DssL3oiykDWulmUKRnoyyL2iSqzV8oThjg61aIrTcMILQsLRn7HMtlYGG08",
+	"7PEmWPSvRXvD4mfEpglXFkJneEC8/4e0AiFHbJAIvM56LIwsikYSOseWF6cnJE7A5ko6MLACltL41OW1",
+	"yanpS5enr7z3ixOzyhjQ/tnbZQQlGKfWMkQ5Pp13ipHm9GJU+x4mZgwckcGOyKh5jo+NGbccJzCCTQcj",
+	"wv/mE5swiE2YJ2jihH/j/JE32kEUPHT/EgII5wF5nEplz9/jl100X7hlQxVzdK6Qr42be8hSnynHNG6p",
+	"TxKboQiOFefUvPyosNuN1+29g2o7FEIKcQxgIZ5bEQGvzGkOcDUSX5VeoCtvU98m5qjetOsN0xqMNyli",
+	"isZmXpZt2+Gwj93wcHrdhXdArgWPAFAHR7GFqEHZ9rIXOD2shOzISkQz3t8jjyHHdmQj6gAzBHt8pWyt",
+	"uwyScuD46fkmjSSgmBuzZg5HRrGUsPTYKdHi6Q7cPs0mDicQtRrigvAmHWLLilwxDMVH7Sun7vqEhTUb",
+	"dtWpVTaAXbav5F7DY/QaEd6TlDILjyd9Qqx1l2qbpdGUaKBEgax3f0xtYWcwvQ3dO2Q4jUPZ2JNqK5Pw",
+	"JX2OgTV0ONeUZ5rXz0QbFcssF+FlIMQOBFfA/xSj+y2OrL4iPOzwMKU8vQElt4hJfiwlWKkzFpTD7+gd",
+	"4QvQ9XhZ0A6DSWIVU3Hbr7yYQPxQokxXbRe0Z64HGp5LamuNfAiICDoraizyvKId5RApmVSLZ94zFAXI",
+	"+InB5GnmpJX25sm8Xc8gODXOsrGcNhm67qIhwJcQzDABpiwhP17Omn4WxObLWYTUsl3sLM8qgutkw8Qw",
+	"HoFnBJt1n53BCRov32FwZVeMQOzzKkN+eBw4Ix95C3K/VRsm/aiQLnYEOcxo4hxlShJWiJE0t33O+zly",
+	"5U3F0y1o5/gNO6+6QOzr17Az6t7UKqeUwpZdAHe8ep2h/D461NwPLFPU2wR1TsD5mByfmlybfH+6VJou",
+	"lf7P0qXpUsm0zI22X3cd34c+zO3A8StOw276sMb3SpZZazvKEJfXJt9ThoDeLbW2E1d9STbYgy+O5ajS",
+	"YwQXR++VSywy+sMOA5yRvV/a0rYEj51yiLAyFYqihdpfdi3U64c9BPrMz8HKcpizYKWsRxDkxzUqHFh8",
+	"kifUFFjc4ZytS16sA7jIrXXRXVKFXkRiKKJNIrz+ay37Cg+RY78VLrhU3FzyF3YJNVuGYhR7bADSgbrA",
+	"aNe4oO8rLStuYqcBQscEH/4jzYYN8FSBGehfvCk0h9NjWfyJ98jjs38pduo+0jVtQfi0HtpqLOCAib5Z",
+	"QJXQ32WlzNW/GJeSvZGqcpSeD+tuHlZGdj+IcbUQEfbwUsngRYljE0bL/pI9xnAMw8PU64EarXWX93ZD",
+	"K9VQWpD3of8KF/HCjhzxPTuiCs2kMcExWqSvu7rOQLoG51ZKp4QBxP4gk6X/I92+fS/utc5fSS1TQFkm",
+	"yIpUY39wuX6dkICRtL8T3GxKq0Cxrzd8Ugk2W46/6TVqGTX1iMsbdzgspFuIAMLZWsRgByq9uuVtsYrH",
+	"gr9Y85LnddOTl60vtS5NXNK1mk9Kr4Wq61KqBf3xNSRWjvm53EISZg5zm3wfpwKipoKnZU5PgWZuu+b0",
+	"h0J3xynL9INaBRLjpy8pLSWZOhM/+7mCtTw5lW5uPz0pIkEzOask1yvDXNKMUpq4kj/OVHqc0sDZpPWz",
+	"S+Rxt78U9+7KpdTeYaEE274rwpZcErbvysTkg4wasjwdcLiyWqmZ6CDlYIgC2L9GO4TVHAtKgZeEh2ef",
+	"PfK9pqY77lgqND2BRLajJOk/7J+5ejJ8zdHf0Pw8YvwZZ81AWjDGtc3BFLjXS0FFMuDHKGUeKsmGgraB",
+	"fE5SMySTQK9n/J1LSKkTnqqYW7lWM0ZEZEByEnCgXuQoDFfX3Rg2GoR5AiLViZ6ogr0D6ELStmPU51XY",
+	"4bsW/ZaSJXj1k+J+jK8pdjAarPKtlHn6iapp5QnFcrzl75RUPEnZJAmMyxpE/MmShER/SYMDP5WCYJ/M",
+	"YrQxsMCpvluJsiZ46+ZH3sZJs3ux+cGxgRTUbgqngaVAMNMMfw019TcsPmJh8e6JCs1maqHsMtK+XxOe",
+	"k7YxAoQdemlJnydCeKG7XnoIudaJ4yZth/J0O0snJg5zZYQlGGv0Ejmre91V0rrHk5dJpi2hxh2KP1dT",
+	"U9MTmYijCMBTQDWE9ACYRviKoc9lheEQqoekT0+A5i9+kOm5BDGaK0jLON9g3U3vKnXlUD0gL0WU1q7G",
+	"3IzxtZgAThBdGVg2nvBV/hec0rorDsNh7qjaih8/oc8Lp8YoJdUlmIYNPGpW16GgCx2fNBy5zglhNU8a",
+	"rxEUwnBpPdjW295yhhOlb1L4SmLvw1Iiv2KqRQNR/txHs1AnUy0zEHCDP09CeGD+idDAsY988v01dJAj",
+	"nPYDK/nFhxk/mLos/uALAsCFloFYc1ERawhYo6rLVyyzeaVU8Z2q59Z8c/r9KYA5a34ofHT5Evvsw+Sz",
+	"yfenPiiVHiRviDN5+cBTysAfvHc5NfLUlQ/TQ79XugxD5xVKDupSrjmo+xoXg+JKSB3ksE3H5RMujIit",
+	"QEprVJPsY8wbea5Nd3fFaVUdN6g3HF8cLD6xocfI6Y4nD54586zeOLq/6QClvR1VfdKmB5xrUyNoU7CR",
+	"0UPJ3EZH/k70SNlkEFNSSYCkDQl47KzMqeHQdZVFzxx+vio9fnxAjAIiRXjjQi1TslzOAHTfZ0jIB4Qi",
+	"nSA6dM4xFH4cGAriCRe4OP9kNNCL03KSEbDZFKqcsB1d1O9+APRFhqCI3fIgvqR2ohIvl3RDNJfsol2r",
+	"5RbcCC2t5Be9SgpWjJXl1bVxqWU/TA56VVOGGfwltnoweLKfBH0KJ7nu/myce3fG8VnLED7hvc2MsCd+",
+	"vFbfcvzA3mpOGOGfpWH7EE8Tnlyt33LtoN1yxqeuvEcUv8+Uekjp9TftqSvv/QSG33TuGteuz8yOr16b",
+	"oWcTiOp1d91cb5dKl6q6SeA3zgQ9wHeAPlw34zbS3TitpascPHYbUlpdQbRrG9X7bxjmKDkpk+5RXWPq",
+	"7t2rCJHN0fHCPntW7YrF7YXo91jX9RofhCgZdKHvcMRfDijNN4g6U1+Ad0Jcka1hF7EN0Wic4s3hAVje",
+	"4iFGtB/x+8mS2I6qHx5MGCqFobkRF6NhqgKEaDV9caRHs2zxMXqzlmiVTe8yQ44Pi5D9e4YA9Y/PYAt8",
+	"XhDH2vZPpzFkuwbGd58yHFWgmg4YcSk0326cMrYb7kt5cQI2UAwDRM/KPby60Y64rUQ+Upk3GZr7KkXF",
+	"aJDrrsIU4j5seIbwGwph7BlhP/7ZvpxIyKvd+nCVGAY8NV8WG+OFL9NNlw7xN91oh21QL3wJXym98sL+",
+	"VekTygvoMPIla/9bqQSQMr+wdVLsrvadassJ2D7GGpeFV8UAZBr4BubA0fRhvKvSfc1uASDLpj3u2T6U",
+	"rinMJiUpEh8KnafcS1DwbAi6FBEWfwfv1CkMSyjvu2pDK6WZVbYMnTBulBeZH4YVmEa7OPAL5tLfYa1d",
+	"MV09fMZaGjNYgQ6k44H0mhb+HXaMhuc1wQi2jCR+H5dX4hZBdkGj7t4eb3hVu4HCgmqcOjKkcB9f2aEt",
+	"ZP3b4SCTS57VhTFJR+C55j2cALaxZf6q5FzBpRU9SUCMOtiLIx5BvVisLxwkvkij9NE59oz46FhWlack",
+	"smdqtTcN+ckaOn1euB3VF6z5krkZBE1/+uLFwPMa/gQbc6LqbV0ERYAHzvxcKNBTaCeFHEDf37VIzyip",
+	"Q9TpgQhl7UjO9H2lm1eBnRKJLbc7lhlvXMHCCcXYESCB3kTYQkVAR8bGkPW22TzVHq7hy3Oj7OSNsnT5",
+	"KqcTEifQUtVw3FrTq7tBrCwLwnAo64apMezuZGVxS+PMJT85EY+CLiCd7junaaKQ3UA1Y1DeDDXdP2XI",
+	"xq4FHCGL0CujsCee+jCcCnq+fMLDSAluS+qEBDZCrp5Q9wi1zC3V9krO24yeIJEPV+kmbENRb6g8BdGz",
+	"8IYYNPoQ0bQ4SPD5pebKuh4U5yz6dFn0/50QRsrTRD0GWJknq+DiRwZNcA+HYtaNuh8UZtOL8PAJqcYn",
+	"xoxS/uth+JGsiw3IH5HfNIJOxrq9GfXa+RU6Cy3nVZwN9zjl7hvqlsS+mQFu2xdxl052PbFBvmSrRrux",
+	"j/bQIDypxF+LHSgV3UsCfSUEkayG2dvMlt41wF2acnBwlqF0I1K9g/8VbVMufpYTi/8ZPWUvDvdkWdYt",
+	"ZGeX4019s9a22A1/+vJULgCg8OTx2u6fvRHL3z60ppaxiuIMcIBPlGF8vGKaEYM7Ow/Q/TgCdGpMpUCA",
+	"TqQZxrwVFjqAefPUviSSPaAFhYBegPS3n7RhJGmhuIEZkm1ep4q/yE03KP8rVT7FOjSRbFJfILWSYnlv",
+	"5Lc/omo0IY55lcKS2mR1HviLx6IEdhkeFry36y7zuqbS146S9MSuNK/HLDrDAhFHCfqBTgZQCsHZ5K6d",
+	"IIbVwDwDOXnjDWcZnCOUvOUIJUVyfU4AlA9RzQHOYv76ytrP07DmflBvNIxN2zd4CdhJglf8MdUUrhv9",
+	"DhInujxfSVdKmmKE2IkZiVBmh8jCBmV2MDgnVMLFMUX5sUbFALHcGJCc8c80A0d9/iErNP3WWJyfmeNz",
+	"vj6zsLQ2s7A0XxYirlqg82ICHOOYmIRQ9dybjXo14IiFPzEQ4Y13/Ewyo5+LnBuLs4DHsy/SdcgGVGNx",
+	"ZFu8MQZPJxCqmLrpGm1+Snlz19oEcAAjhdzeOEahWEyaXycqldXMQPsmnG1+UaimFkfOauadAPOCeQqd",
+	"jAZjiBSuKVkPe6JxipcywUiOMQxYEkOCE6P2ixTADoWAMr/FQoYTDpsEjw7IZob/HUW/QWo9IPwiy2B4",
+	"hwLldykHFeciGOcSX7DWXf92vWmME1NmyUfPw06i6VD0SmxsEB7oVD0Kb/PEA7En/e94j/+4i5k+jh7n",
+	"G72Kvg2fsX4vwCbiPr5dHdwhAzuEdWhR52KqHaJs67rD07NVp33TbkF+cF4/m79pFOp04xnu1lQa3CCk",
+	"tODtTyo5JaTpng7eJS9PPCd/mm/QmTSr4abKm+YlcdVhDi8Bkmo6tQJSsWdxmO3XTF7EtsjrDPEFg5tW",
+	"MZIkcpxlY2iz9dm2DiLsYjjOqn4/YmB9kK6m6QITH1G6E4x1SupoXhebc1Pm1NaZBrsknZJ1tZbrvo/C",
+	"vk4kY3UdFz94jjJK3NlbOsV4/6mZQNfnr380X67MLi99vLgwu2ZasT5EnLbabqniK9GqqAQG17G8dm2+",
+	"XIFLamoQQOLH5m6sLC7MEvqh8NRl5LbJpYav4vu84TQ895YPpYG26wWbTgvr+aeNqZhJ1t1bp2KiKVw7",
+	"rbuFvYG6m4S1mc535v2NeIr6gB5RiiqVqXYaFwQd7Gm0cxHTEo8I2p1en9MkfmyA+ce0nWwj8K8DWuhl",
+	"JEYzMhd6PSXNzMNn6W61KUX6dxxYcU85hXU3+5361lL4sJrNanEc+7Bn0G74TkC7UfYajpX2sCROlXU3",
+	"wZT4IexKE0TzcKSu7Mw4ZCfy7pmIEmNp2AGEjMZvtesIxFS0T2/LaziFwBXguYH1kYWh/0Sd+PShzbMW",
+	"D28uAgShRXsoFqNS+aDOPXiu9Jz7b7P8t8OiTQ/TA/OUppzfuDGRR7xHg2wLsKy7vCIgfAXz+BKcwe4w",
+	"Plhqt5krgmVnsIgoBZucABuKEWVSh/V6sw7woiPUNDDJSPYrTTMpjiIB5YO7Jye2qJGJGLQ7kZBjngRl",
+	"2/luy8+Gc8uu3jMH4BEN7fA5k24dvH9sGoB5imNFWGZCTiTA3gfB+4H5hYjahMfmOl/GvT3EBjmNmvrx",
+	"+0L3BblTSAYm9aWUZ2jwtkuLK4q9LC02q5eFNl5e4IL/Hr86NNTyWNHBlJqR6kMSN/3U+Y+lSPy45T+z",
+	"vHrhofCOBMO4qMuMKmbLAqZDlttsBI+pSADyyUq7+MXwrraUoDrXxc51sePhZvwhJie5tabie7iQjk9r",
+	"bnYSk7CoRJhnLT3J6L6c63sAJ/zNeqNBl5UFaQopQIesCZ2UtcNUrqSZpozLrAPnwvpQyEyN5QhiCbGg",
+	"mRxz6URfQe6SVl9C+hEbd7G8oKPokebN0aMJI/z/KOz4AxEfxh4TN1Jm13AR5jrTC/LYUE9NLDN9RScb",
+	"u2AU95VCFkRh+wKuNFxcKo0WivMtRNCmlDVe2s9+JywOqtr7zJWEjiRWHQ1bCcX8PRnJTOqWvsd/3GVa",
+	"p+xC4qNhqFRBA4VlicT6rXAiCQxCQQ12RJ30oxStv9vaaRxAexfVU854mH5p12qp7nHYOC6vk0kWhumQ",
+	"myRO5f4wug0nqBPUa4S5FO1bLrM1ia+Ipv+5CnOuwoyuwiCzJ0ara7TZZ9JalzKX3ZQppY2kvBv5fWfh",
+	"93Opn/w4mHrsAJcbWufZxI1GxblbdZqBlHLFUPs1lKsxGPF0YxgSvddKTdQ+wB8fhl0jnrGl6f9TKETg",
+	"Z2Q6P+fpYVlzvhAXjfKnla6vYsOOeKN+AqJsbAgTfXC8wj8b8ak6bpKG54MdN1f0jpupYR03FIpOvYCa",
+	"PWhecEl6AetSmj381CiyvJCPZyR3yYk6cpRmEWJXJZGDso9WylcNZZuNn2C1ubbbc7TLmDklH8ZdyBFN",
+	"LtqOHr8VHpzj+Wz+WcBS5oeKXKqf4z3LAJ4+15zONafjBuKgNyWXDPqwnKxn/TdSNR3QAJFXQDobYU9P",
+	"3T0huzj7ZuSpaqx6P6uIH57/xAmOW3N1bDTvtybJtWgO1VrclVaVGuGz6P8i0Ix3z3xIlcYXzLrKo8BB",
+	"OBLwg5HgI44BejP48eVWzWnlt0/zvVZAXcw1fdNMJj55Cr6SQc4asjSlZHIR8Ef3wrpbbbRrToUHVfRv",
+	"ZoaEqtkf/45KKDymc+/ff73wS6++sfVx8IvVBX9h6z/qy1u/2Ny4ttRYnP33Kfju51sf/9Ke+rT9i1n4",
+	"3q8v1/+9/vPPllq/+OzK7QW3FDdcQc8S3t4KB6jnW8U/uBLvGP/k0ghdyN5+ICFrhNY5q+wCn2CjtBQg",
+	"kcAI3h44Ihl6SE0fTXDkxNnzSqEU/jyh6b5m+g1f/Neg5eSGZloO1NUUyAzNU2wzs0I7OT25BmSmKF24",
+	"CVJTo4xMGGLaqqb2Saot3ybjRVedmEDYGhexo9LFoGW7/k2nxYudtLqXUP6IECRoDgECXXhoKbXsqZ7i",
+	"wm9ZSeLArmXcI1UWz+1/aP7ojyofVDbmT8xyVtY6rAWcRfacsKOnZAS+eAvaXySg1R3xLtLs+gmP0PCi",
+	"cxP83AQvSPnxziqlJFLdQrqAPwlrZMtLJNSM/AId0eaKdc4XMwT6d2T8U6HLoQKyRcDpSj0HwvHp+ntr",
+	"0A3oBHvpuAyIUmqe81B8OSuq5OjgLIcgbvySZLKwVNVt0D7w8SPKh4OOAHajbvsV526z3nL8ih2su5nt",
+	"zYVO5kKC2iHVczIwMtR3GGQAph1gLePM4sLMamVtbXEsXzozefKuyWVwAEt2Sb3RAJk5fIG+MtL9Eytj",
+	"lgc+myxXhbLiVNep8UslKdW1Cd5zr+1nVd7p9jY32qa+WAf5xDXsWKekmwvY8un5KLD7MXBHOou9WL6r",
+	"bsUnd9ia0a30roySgMkvPeNzKjhb6Y05ughFsRMeEGfj84PktZwS5thJzBjqUdroDPvnas65mnN8J6vm",
+	"3mjcrXnaiVR9maOk5MHZ5RVlXqAHqatLgRIUVpHe4z16VGUGfA9SjSs4KcYKgOV9L7VQ5dNXpys7QOg2",
+	"jwQ3tCrt67unfVBBqAlIU6aVn7DyLhWXWjSZ/xE1poCsfsRsi5cCfNi56DkXPadpYX+Xhp5jtJcyWqPd",
+	"IQxp3wlWEAoqz5bmkCQxIEnYiTfaiMVKbGRSn6/wMGXTh13LEJG8kjukijBy9zMpt+5qn0mLuR3yNzMZ",
+	"R2DYgGM2hn3URGA01YVOeb9f4TVhAHsPmcf9idR0rTjgX54UY1v+7kmwNGyYebPuBk51cwQTugAG2Xdx",
+	"iYWWBHRwZBT2S7An0ZrYERjIuPKT/NMvGhR8iwoEjpkjkTLX+N3GToq9aIfn+CGiS7q8CD5+U+2hOf+J",
+	"0VgkuBkDW1AehK/OpfXbJ637iIClMvW3JDetIPcZxsDkzYp7eoEEb+6iQKGyx9wsnqDlOIOyeNbgmZTE",
+	"SS+eQtZJ5419Nt+9sHM1RinD0jlKlSWxl2r0KRStgTs7ZRMO4Lm6DBvR9khO+91Iz0lSaaqb9Uat5bjq",
+	"H1+oSTWXLdO747Ra9Rq90/GrdsMOnErgVZpMZ2NCIlcs+04AUGS5YyS5wxXPrSRZxfGOyHWl5vSU8JHv",
+	"NJwqo6CW7da8LfNBljaQWuWkbvaU/T5o4vHUTnHmfBtzE5eGTwpa8mrOSWYE/Ylf0uixwpTeAZfbf4mM",
+	"Tpk+csI9lBJUX8x5UR47bDfBpb8qkE+GZfNnsUsuNxxeUVNkelOft6GmICZwNUNGfIQS8mhXBLwV6yDC",
+	"LuHjFrJgIHr4nYqQS3FTATy5Y9TdTadVD+BDirumCoUxRYc5LzPAePNMlBvyBr57dgrbIbEVL7v/X/Dr",
+	"X/EbdmXTa8OSPsjgCV96rdt19xZ7bOg6Gh3Tuq+p6opnq7F/FGpIAXdzwNCwn3Xkogs7kxS14feYnfE8",
+	"2QxWq909zT6nmLGl2yLLdL1K1XZrdSBCDoAOcqN1K/5Th5Ct1tRIPxBWYbswnN1stjxSBKCWLf5TN7Ju",
+	"QsKATbt1GxeHGN66AbJklI4a0uSpkkUpRh6Pvo1d6quLM7BJdbe+BbMqpdtapWXhfXPLvkvPT5aEH0/m",
+	"/li4IQJpkPi0lDvzxdtvLgtsQ9K3RmUTD46tuXCNLO/9SZ51sSkNybqknRiY4syWuxz/SNmDogMcI5Lu",
+	"J8IqmXsh3ekPED6OdjngB+OqaTH8hhMA9WnW3ZQ2Is+9Gx6cOzvOQxPHV9FfSJnlA+8HAe5wXByNgq2G",
+	"m/NAlihd3d7wHbfqDNUgjhLVeVGwPjPxW0s6mm5c+Z6TUDswNp7RQg2ikf4MW8ibUasL/Ibmt1DLrB+8",
+	"nFGQrbrapY5q5+k5b5ARjXwPhiL7s+dkOqpL87P+gI5jmjCRwIrw0upY0YCeY28vMwI7X2RF72YfL8et",
+	"Kdmqk1fWSqUkWzVu8HDHplFMWef2A7sVO0DxD2W80qQ0nlS1nGf384kVBVXlE72fbzmy+Q72V0urKTqJ",
+	"wjlASd5P8hYrXvTZd0gWFIM8ZsLF7iBkkT8j+h5WKCTVASJGSF8v6X7gdsPXCUgIXM3UCSJgzknChyjn",
+	"Y8f6xbDlUFoZLnZN6r9F5aU9zAGnBMTOeUz3XLs44xQuUcNQ9Ys/61qjZ+oaaCx1WM6vgTHULqsbGjgB",
+	"Ka8Wnf8aXLnDsULqzCAwCFFlGAkUAgaIzYoBgdqmjS849SDtAKlSPK4nyBfVEz2CaI1fPxrjziaeJ1TQ",
+	"FpMZWfWi5Oq8QxcthYnSH2InCl0KCib+KNR8Cuu9g5o+Owu8QpOWRvGfKgmKel7BmTCQoBfX3eC9y6Yu",
+	"6HHqqvwJqO569e/NlPkfVxd/C3VioUPdU6yf7YddDZ/hXQ7OdeNz3fjc85bpeUtFErL04eMED9LSfADS",
+	"Hv5gFKg9SaM9dpYgL2hK4eUhupbdDja9llMTUufwcxYozkfeGoi5lyM1i9ZZrbS8m/WGk2K+xcutvsee",
+	"P7+BO58DJfEu66cZS2Jl0diHgiGa4bDP4XKHLyhDZxCFk1wsQufsyTdL7SJmMb2eKFxAk8xrXCB9xyEn",
+	"azXDdyDHl7yTQduHNsAr80s436IOXGVmBdWSlTa0xcAfrW56reCELEF5MsUA4gR4tZXyv/FGeRmXKZ9i",
+	"V8r/hti/zykFM8cjIeH+at0QuQRc9/22g+J9GGOrWD0WViv/HSdxYCiqBtmHOhiVySmDSutYpy+87M+E",
+	"FjICzkpWOiWucyFZ2tDG18nikgWNiu9UPbfmm9OX3iuVhkAjE396f0Cu2NBkfvaWiow6Usy4Czh15i8q",
+	"YCc9LITHH6NHgsLzUlCRz960yL4ssVpGejtdbriLvFaG2ik9xKzEmdm15XJlbfmn80uV1fnZ8vxaIjOF",
+	"JKWjM7dQfhwpRT8Obzmj+wSzkpmFWbpSuI//kwzKXNFSyLH9I4Q5TpQInqabfBLr/3qIY+Vs/yFQna7D",
+	"npr8lXJlCv3aMuDfxk6l7Cu2pDQ/TkIH1n0dMe/HHAqqLviGGRdYFRzsA5SVhh1Sdp4nlzBrLYlumrmQ",
+	"k0WCpkqqts/JOWVZFkAh0YG6D4HjPLB8ms2uoJZNgB4D3H005GhgylquA2z5HYRWzlwL2pvMzmYbANXc",
+	"BSCW09zVd4IFf4ZRVV6rKfzpqvD0O1hYlNwfFoYsalMKv9RXfbDgAHlzdD2cvscW5ko7iw7WtOd3qOgP",
+	"j24NpWj/yRNejijNnHvj+tQzNfmp2JczBQegygZ90QNaT+ldGcFcTvb5TEpGzrRTFHNh6AnxpL1+uUlS",
+	"3+u7/aQaxb7NPZeGgo8aIVBErDrDYXIekjmvyjgp++mfrEsfUR+Dh/oNph89F1soH9FGhL3RMiJ4v4Bj",
+	"QkWpbu68rIewm5fGokBJ5SRArPGpv4OggRTd4VWnMVvOAlwqrpEoI4uG40273jBTBPovyk8TgbSibVW9",
+	"OAx7Av/PPL7pdfe24zQBjSnVZJIv0RhPGJZGraF+GDuoFdEQ0cMUBUWPrHUXlmOMx1WzhKvN4zEcUqAf",
+	"7itZvplKEyK3RI/XXcGyhtUI6QWmRZs4bAHsSDrPGZfJDtZ5Lp22zpM2XrMuwDF0njela6iHPELWSqYs",
+	"Segd0CCehkfnisg5FtbQGonQJzinjpQrUR8WZy943TZtf7npuOXEEhaUnf8k3pvLoC9Iou0nwIjBhXHH",
+	"brSpcghWTpOqOea0eW1mtQJB4kp5/tOF+c9WEW3C9+1bDnOiGn5QbzSMTds3vKbjGtxIx01zvVkODqHM",
+	"lflvKJ9HK8E4Clg/29utLIZzgtwFLS1XZmeW5hbmZtbmpcW4nkEs1IgBLXzDvmPX0UVn3PRabG2wtAfW",
+	"iVFSXoOtI67lvUApHMPsQI8bwVecktZZyGp7HFkte0/DvbhZlaalVq4WPDAf+L/lvCTlliN+vSXElUlj",
+	"AbfbE1CmeklfkK+p/uZrAvihRmCoKHGXeZIElfhf4Fbsc7c6qWPrLprY2IOEpaP1pjV2QfTYGDfSPj1L",
+	"VtE1qHfjakcxa92NviYezfhfPwVx24m+YqslbPJxg8Cb7FqNQL4tI93CDX6hwVgHb9Vbk4adlwjwzmZg",
+	"Q0T8156LEQC/bl/8udNy7thurrJV9jacVsAgYSrgjpo2Jz+cLpX4RywJ2pyEvO3c3IP47anb9i/9PVqY",
+	"WZoZrshRnDri4iw67q1g05yeunIFoXH435OaYZMVajvvH4Xd6Lfixe7yZvyAL3zh2rXp69fHzKxxhbLP",
+	"FDgUqzUcfuwzz8N482mOEj0m4DhUsCvQpkyWVibtPzjpnMnRfYxDpE0OeVaqZrGw9OnM4sJcZWFp5caa",
+	"pFrU3Tt2o14z6m6zHUwn4dKtth8YrhcYG47hbDWDe+ZJKhbFYXFwV5I43rnFc54N/xb4blP58MXu8kgp",
+	"8pbeYnsRdmj7IIOBN83KS6n/0tnY9Lzb/sVb9WCzvZGjC2MWBQWko8fGz8avtTfGV+u3XDtot5zxqSvv",
+	"sfUyOOBEjxWTyGDKxicLa9dufFT5bP6ja8vLP2W5ZBPr7kqZL3o3hsKr1zhmaMfwvnSd1sWW0/T+l9sG",
+	"fXECLTinpmKDr5TB8ci+S4xLvh9MiU5smJfQ/e9CkvMmjoVn0wtfxKYI8ukxy6g2PB+Gj4fiw8NgLK0E",
+	"YQvZRZcckuHRuksdfqOH1LfPkowk2j76PTbn6Yd7dCO42r4v+FoPENwdXA2woLCnEg3vUdjF+C6mCQCd",
+	"gKot2SFkzikD9VJ5g1bqmT7bKPz8tTCzVzySCBZCJ14DJjHDPqy7uv2NvsIjhFVRezHAY8BPVMBMZh29",
+	"YJOIzxT9Gi/G4XCMGHc70wiAQ1l3tfWmmtTnPYsovUcdHWWs1rAXPofXwPfAvgZBtH7G7t8ndP1S1gRm",
+	"Hm2S1h+nHv1s/JN6APdv/g6hbMpKXZHcqvSQqfs8OLVpGAMkNdRZJQc3W0Mk+8tln2kOiEoICDzxBiCz",
+	"RtKQDj/GDu5PC7QvSPjURbGQdahiJs3q90Sa7sYuvCO857KgxsukI2J9wauPMaMECpR4KFJY/E9ifBzF",
+	"Ff5Rv+WClq9NP1R6OuMLChY+SBvcD5/FhhELkMYLz938tyLVK+zzQ4+XdPaqqyzBczXND89uWtB2nzeL",
+	"FAViyiWIB/s0PDSYzjIu7edLQww8kUgiLiloPgtQ1kAcWKcANew8BegfsVqP/LdhbzCdPdrW6Dyg5SzO",
+	"JFoO5s1PGNfzdZx191bLazcvNlseXIz/rV6vkZKTreJoFBxDo98whUWjr1jrLtNTMhUQcpQWU41Ub/5L",
+	"ZGo9rvgkRu+TuKFr+IJd1l7YXXflIcnlfpUniosro6+EWgWBevgPccMF/YW3IKX+zGxLaABa67qrQGqu",
+	"lJPYNfUvou7XVM73OnzN+Q9d9Q5mTIPLG4PTyWRA7yAF5iErtuijHpmlM8Gr1l01gyLRoToTQN+L9gaN",
+	"ADfhd0lLxvgpxWdNIYx9RWEMu3T4dA6vUEFlBh5BjLAelofRrl5jo1OW6YNUoldhL/qGWT/SOzH4Tgco",
+	"LWpEZXfdHaDtXk2yE85c2QWiNihw9A4quxkqrXGB0Z8GwZxxVaIdBK9HMxCGwJ2Ivgl7IMrDg7ECmnHD",
+	"HkozbtgnqxmLzP5cKT5XiospxSQzz9Xjd0Y9/ofktv1RqcbXUb9j19m4hpyZKciLdp6C/CD+6j7nh4Tm",
+	"/cCKPyB3ovCBwDqkz1cDW/5g/m6TauvjT6T3iz9tb8QbI31xzbEbAfZ0+v8HAIZbf3tUlgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file